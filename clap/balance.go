@@ -0,0 +1,68 @@
+package clap
+
+// BalanceFact describes a balanced matrix B = D^-1 P' A P D,
+// where P is a permutation and D is diagonal.
+// A and B have the same eigenvalues.
+type BalanceFact struct {
+	// Balanced matrix.
+	A   *Mat
+	Job BalanceJob
+	// B(i, j) is zero for i > j and j < ILo or i > IHi.
+	// ILo and IHi are zero-based and inclusive.
+	ILo, IHi int
+	// Permutations and scaling factors, as returned by ZGEBAL.
+	Scale []float64
+}
+
+// Balance permutes and/or scales a square matrix
+// to improve the accuracy of its eigenvalues.
+// Calls ZGEBAL.
+func Balance(a Const, job BalanceJob) (*BalanceFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errBadBalance(job); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return balance(cloneMat(a), job)
+}
+
+// a will be modified.
+func balance(a *Mat, job BalanceJob) (*BalanceFact, error) {
+	n, _ := a.Dims()
+	scale := make([]float64, n)
+	ilo, ihi, err := zgebal(job, n, a.Elems, n, scale)
+	if err != nil {
+		return nil, err
+	}
+	return &BalanceFact{a, job, ilo - 1, ihi - 1, scale}, nil
+}
+
+// BackTransform maps right eigenvectors of the balanced matrix B,
+// stored in the columns of V, to right eigenvectors of A.
+// Calls ZGEBAK.
+func (f *BalanceFact) BackTransform(v Const) (*Mat, error) {
+	if err := errNonPosDims(v); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(f.A, false, v); err != nil {
+		return nil, err
+	}
+	if err := errBadBalance(f.Job); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(v); err != nil {
+		return nil, err
+	}
+	n, _ := f.A.Dims()
+	_, k := v.Dims()
+	x := cloneMat(v)
+	err := zgebak(f.Job, right, n, f.ILo+1, f.IHi+1, f.Scale, k, x.Elems, n)
+	return finiteResultMat(x, err)
+}
@@ -0,0 +1,37 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGEBAK: complex double-precision GEneral BAlance bacK-transform
+//
+// http://www.netlib.org/lapack/complex16/zgebak.f
+//
+// ilo and ihi are one-based.
+func zgebak(job BalanceJob, side matSide, n, ilo, ihi int, scale []float64, m int, v []complex128, ldv int) error {
+	var (
+		job_   = balanceChar(job)
+		side_  = sideChar(side)
+		n_     = C.integer(n)
+		ilo_   = C.integer(ilo)
+		ihi_   = C.integer(ihi)
+		scale_ = ptrFloat64(scale)
+		m_     = C.integer(m)
+		v_     = ptrComplex128(v)
+		ldv_   = C.integer(ldv)
+	)
+	var info_ C.integer
+
+	C.zgebak_(&job_, &side_, &n_, &ilo_, &ihi_, scale_, &m_, v_, &ldv_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZGEBAK", -info)
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("ZGEBAK", info))
+	}
+}
@@ -0,0 +1,33 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGEBAL: complex double-precision GEneral BALance
+//
+// http://www.netlib.org/lapack/complex16/zgebal.f
+//
+// ilo and ihi are one-based.
+func zgebal(job BalanceJob, n int, a []complex128, lda int, scale []float64) (ilo, ihi int, err error) {
+	var (
+		job_   = balanceChar(job)
+		n_     = C.integer(n)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		scale_ = ptrFloat64(scale)
+	)
+	var ilo_, ihi_, info_ C.integer
+
+	C.zgebal_(&job_, &n_, a_, &lda_, &ilo_, &ihi_, scale_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, 0, errInvalidArg("ZGEBAL", -info)
+	case info == 0:
+		return int(ilo_), int(ihi_), nil
+	default:
+		panic(errUnknown("ZGEBAL", info))
+	}
+}
@@ -0,0 +1,39 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGECON: complex double-precision GEneral CONdition number
+//
+// http://www.netlib.org/lapack/complex16/zgecon.f
+//
+// Estimates the reciprocal condition number in the one-norm
+// given the LU factorization and the one-norm of the original matrix.
+func zgecon(n int, a []complex128, lda int, anorm float64) (rcond float64, err error) {
+	var (
+		norm_  = C.char('1')
+		n_     = C.integer(n)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		anorm_ = C.doublereal(anorm)
+		work_  = ptrComplex128(make([]complex128, 2*n))
+		rwork_ = ptrFloat64(make([]float64, 2*n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.zgecon_(&norm_, &n_, a_, &lda_, &anorm_, &rcond_, work_, rwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("ZGECON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("ZGECON", info))
+	}
+}
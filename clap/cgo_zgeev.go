@@ -2,26 +2,35 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zgeev_
+// #cgo nocallback zgeev_
 import "C"
 
 // ZGEEV: complex double-precision GEneral EigenValues
 //
 // http://www.netlib.org/lapack/complex16/zgeev.f
-func zgeev(jobvl, jobvr jobzMode, n int, a []complex128, lda int, vl []complex128, ldvl int, vr []complex128, ldvr int) (w []complex128, err error) {
-	w = make([]complex128, n)
-	rwork := make([]float64, 2*n)
+//
+// The workspace size is only queried once per problem size if ws is not nil,
+// in which case the eigenvalues are stored in its memory.
+func zgeev(ws *Workspace, jobvl, jobvr jobzMode, n int, a []complex128, lda int, vl []complex128, ldvl int, vr []complex128, ldvr int) (w []complex128, err error) {
+	w = ws.complexes("w", n)
+	rwork := ws.floats("rwork", 2*n)
 
-	// Query workspace size.
-	work := make([]complex128, 1)
-	err = zgeevHelper(jobvl, jobvr, n, a, lda, w, vl, ldvl, vr, ldvr, work, -1, rwork)
-	if err != nil {
-		return nil, err
+	key := workKey{routine: "zgeev", job: [2]rune{rune(jobvl), rune(jobvr)}, n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]complex128, 1)
+		if err := zgeevHelper(jobvl, jobvr, n, a, lda, w, vl, ldvl, vr, ldvr, work, -1, rwork); err != nil {
+			return nil, err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
-	err = zgeevHelper(jobvl, jobvr, n, a, lda, w, vl, ldvl, vr, ldvr, work, lwork, rwork)
-	if err != nil {
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
+	if err := zgeevHelper(jobvl, jobvr, n, a, lda, w, vl, ldvl, vr, ldvr, work, lwork, rwork); err != nil {
 		return nil, err
 	}
 	return w, nil
@@ -50,9 +59,9 @@ func zgeevHelper(jobvl, jobvr jobzMode, n int, a []complex128, lda int, w, vl []
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGEEV", -info)
 	case info > 0:
-		return errOffDiagFailConverge(info)
+		return errOffDiagFailConverge("ZGEEV", info)
 	default:
 		return nil
 	}
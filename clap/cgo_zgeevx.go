@@ -0,0 +1,93 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// Result of ZGEEVX other than the eigenvectors.
+// ilo and ihi are one-based.
+type geevxResult struct {
+	w              []complex128
+	ilo, ihi       int
+	scale          []float64
+	abnrm          float64
+	rconde, rcondv []float64
+}
+
+// ZGEEVX: complex double-precision GEneral EigenValues eXpert
+//
+// http://www.netlib.org/lapack/complex16/zgeevx.f
+//
+// If sense is true, computes left and right eigenvectors
+// and the condition numbers of eigenvalues and right eigenvectors.
+// Otherwise computes right eigenvectors only and vl is not referenced.
+func zgeevx(balanc BalanceJob, sense bool, n int, a []complex128, lda int, vl []complex128, ldvl int, vr []complex128, ldvr int) (*geevxResult, error) {
+	r := &geevxResult{
+		w:     make([]complex128, n),
+		scale: make([]float64, n),
+	}
+	if sense {
+		r.rconde = make([]float64, n)
+		r.rcondv = make([]float64, n)
+	}
+	rwork := make([]float64, 2*n)
+
+	// Query workspace size.
+	work := make([]complex128, 1)
+	err := zgeevxHelper(balanc, sense, n, a, lda, vl, ldvl, vr, ldvr, r, work, -1, rwork)
+	if err != nil {
+		return nil, err
+	}
+
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	err = zgeevxHelper(balanc, sense, n, a, lda, vl, ldvl, vr, ldvr, r, work, lwork, rwork)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func zgeevxHelper(balanc BalanceJob, sense bool, n int, a []complex128, lda int, vl []complex128, ldvl int, vr []complex128, ldvr int, r *geevxResult, work []complex128, lwork int, rwork []float64) error {
+	jobvl, senseChar := values, C.char('N')
+	if sense {
+		jobvl, senseChar = vectors, C.char('B')
+	}
+	var (
+		balanc_ = balanceChar(balanc)
+		jobvl_  = jobzChar(jobvl)
+		jobvr_  = jobzChar(vectors)
+		sense_  = senseChar
+		n_      = C.integer(n)
+		a_      = ptrComplex128(a)
+		lda_    = C.integer(lda)
+		w_      = ptrComplex128(r.w)
+		vl_     = ptrComplex128(vl)
+		ldvl_   = C.integer(ldvl)
+		vr_     = ptrComplex128(vr)
+		ldvr_   = C.integer(ldvr)
+		scale_  = ptrFloat64(r.scale)
+		rconde_ = ptrFloat64(r.rconde)
+		rcondv_ = ptrFloat64(r.rcondv)
+		work_   = ptrComplex128(work)
+		lwork_  = C.integer(lwork)
+		rwork_  = ptrFloat64(rwork)
+	)
+	var (
+		ilo_, ihi_, info_ C.integer
+		abnrm_            C.doublereal
+	)
+
+	C.zgeevx_(&balanc_, &jobvl_, &jobvr_, &sense_, &n_, a_, &lda_, w_, vl_, &ldvl_, vr_, &ldvr_, &ilo_, &ihi_, scale_, &abnrm_, rconde_, rcondv_, work_, &lwork_, rwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZGEEVX", -info)
+	case info > 0:
+		return errOffDiagFailConverge("ZGEEVX", info)
+	default:
+		r.ilo, r.ihi, r.abnrm = int(ilo_), int(ihi_), float64(abnrm_)
+		return nil
+	}
+}
@@ -2,21 +2,30 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zgels_
+// #cgo nocallback zgels_
 import "C"
 
 // ZGELS: complex double-precision GEneral Least Squares
 //
 // http://www.netlib.org/lapack/complex16/zgels.f
-func zgels(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
-	// Query workspace size.
-	work := make([]complex128, 1)
-	err := zgelsHelper(m, n, nrhs, a, lda, b, ldb, work, -1)
-	if err != nil {
-		return err
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func zgels(ws *Workspace, m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	key := workKey{routine: "zgels", m: m, n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]complex128, 1)
+		if err := zgelsHelper(m, n, nrhs, a, lda, b, ldb, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
 	return zgelsHelper(m, n, nrhs, a, lda, b, ldb, work, lwork)
 }
 
@@ -40,9 +49,9 @@ func zgelsHelper(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb in
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGELS", -info)
 	case info > 0:
-		return errNotFullRank(info)
+		return errNotFullRank("ZGELS", info)
 	default:
 		return nil
 	}
@@ -2,33 +2,44 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zgelsd_
+// #cgo nocallback zgelsd_
 import "C"
 
 // ZGELSD: complex double-precision GEneral Least Squares (svd, Divide-and-conquer)
 //
 // http://www.netlib.org/lapack/complex16/zgelsd.f
-func zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, rcond float64) error {
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+// Returns the singular values of A in decreasing order.
+func zgelsd(ws *Workspace, m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, rcond float64) (s []float64, err error) {
 	// Singular values.
-	s := make([]float64, min(m, n))
+	s = ws.floats("s", min(m, n))
 
-	// Request workspace size.
-	var (
-		work  = make([]complex128, 1)
-		rwork = make([]float64, 1)
-		iwork = make([]C.integer, 1)
-	)
-	err := zgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1, rwork, iwork)
-	if err != nil {
-		return err
+	key := workKey{routine: "zgelsd", m: m, n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Request workspace size.
+		var (
+			work  = make([]complex128, 1)
+			rwork = make([]float64, 1)
+			iwork = make([]C.integer, 1)
+		)
+		if err := zgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1, rwork, iwork); err != nil {
+			return nil, err
+		}
+		size = workSize{int(real(work[0])), int(rwork[0]), int(iwork[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	lrwork := int(rwork[0])
-	liwork := int(iwork[0])
-	work = make([]complex128, max(1, lwork))
-	rwork = make([]float64, max(1, lrwork))
-	iwork = make([]C.integer, max(1, liwork))
-	return zgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, lwork, rwork, iwork)
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
+	rwork := ws.floats("rwork", max(1, size.lrwork))
+	iwork := ws.ints("iwork", max(1, size.liwork))
+	if err := zgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, lwork, rwork, iwork); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
 func zgelsdHelper(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, work []complex128, lwork int, rwork []float64, iwork []C.integer) error {
@@ -57,9 +68,9 @@ func zgelsdHelper(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb i
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGELSD", -info)
 	case info > 0:
-		return errOffDiagFailConverge(info)
+		return errOffDiagFailConverge("ZGELSD", info)
 	default:
 		return nil
 	}
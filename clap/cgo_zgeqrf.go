@@ -37,10 +37,10 @@ func zgeqrfHelper(m, n int, a []complex128, lda int, tau, work []complex128, lwo
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGEQRF", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("ZGEQRF", info))
 	}
 }
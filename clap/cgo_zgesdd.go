@@ -2,29 +2,35 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zgesdd_
+// #cgo nocallback zgesdd_
 import "C"
 
 // ZGESDD: complex double-precision GEneral SvD by Divide-and-conquer
 //
 // http://www.netlib.org/lapack/complex16/zgesdd.f
-func zgesdd(m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int) error {
-	var err error
-
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func zgesdd(ws *Workspace, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int) error {
 	lrwork := min(m, n) * max(5*min(m, n)+7, 2*max(m, n)+2*min(m, n)+1)
-	rwork := make([]float64, lrwork)
+	rwork := ws.floats("rwork", lrwork)
 	liwork := 8 * min(m, n)
-	iwork := make([]C.integer, liwork)
+	iwork := ws.ints("iwork", liwork)
 
-	// Query workspace size.
-	work := make([]complex128, 1)
-	err = zgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, rwork, iwork)
-	if err != nil {
-		return err
+	key := workKey{routine: "zgesdd", m: m, n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]complex128, 1)
+		if err := zgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, rwork, iwork); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
-
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
 	return zgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, rwork, iwork)
 }
 
@@ -52,9 +58,9 @@ func zgesddHelper(m, n int, a []complex128, lda int, s []float64, u []complex128
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGESDD", -info)
 	case info > 0:
-		return errFailConverge(info)
+		return errFailConverge("ZGESDD", info)
 	default:
 		return nil
 	}
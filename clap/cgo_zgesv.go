@@ -2,13 +2,17 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zgesv_
+// #cgo nocallback zgesv_
 import "C"
 
 // ZGESV: complex double-precision GEneral SolVe
 //
 // http://www.netlib.org/lapack/complex16/zgesv.f
-func zgesv(n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
-	ipiv := make([]C.integer, n)
+//
+// The pivots are stored in the memory of ws if it is not nil.
+func zgesv(ws *Workspace, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	ipiv := ws.ints("ipiv", n)
 	return zgesvHelper(n, nrhs, a, lda, ipiv, b, ldb)
 }
 
@@ -25,5 +29,5 @@ func zgesvHelper(n, nrhs int, a []complex128, lda int, ipiv []C.integer, b []com
 	var info_ C.integer
 
 	C.zgesv_(&n_, &nrhs_, a_, &lda_, ipiv_, b_, &ldb_, &info_)
-	return zgetrfError(int(info_))
+	return zgetrfError("ZGESV", int(info_))
 }
@@ -27,15 +27,15 @@ func zgetrfHelper(m, n int, a []complex128, lda int, ipiv []C.integer) error {
 	var info_ C.integer
 
 	C.zgetrf_(&m_, &n_, a_, &lda_, ipiv_, &info_)
-	return zgetrfError(int(info_))
+	return zgetrfError("ZGETRF", int(info_))
 }
 
-func zgetrfError(info int) error {
+func zgetrfError(routine string, info int) error {
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg(routine, -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular(routine, info)
 	default:
 		return nil
 	}
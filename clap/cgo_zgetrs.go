@@ -29,10 +29,10 @@ func zgetrsHelper(h bool, n, nrhs int, a []complex128, lda int, ipiv []C.integer
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZGETRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("ZGETRS", info))
 	}
 }
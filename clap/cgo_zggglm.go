@@ -0,0 +1,55 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGGGLM: complex double-precision Generalized General Gauss-Markov Linear Model
+//
+// http://www.netlib.org/lapack/complex16/zggglm.f
+func zggglm(n, m, p int, a []complex128, lda int, b []complex128, ldb int, d, x, y []complex128) error {
+	// Query workspace size.
+	work := make([]complex128, 1)
+	err := zggglmHelper(n, m, p, a, lda, b, ldb, d, x, y, work, -1)
+	if err != nil {
+		return err
+	}
+
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	return zggglmHelper(n, m, p, a, lda, b, ldb, d, x, y, work, lwork)
+}
+
+func zggglmHelper(n, m, p int, a []complex128, lda int, b []complex128, ldb int, d, x, y, work []complex128, lwork int) error {
+	var (
+		n_     = C.integer(n)
+		m_     = C.integer(m)
+		p_     = C.integer(p)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		b_     = ptrComplex128(b)
+		ldb_   = C.integer(ldb)
+		d_     = ptrComplex128(d)
+		x_     = ptrComplex128(x)
+		y_     = ptrComplex128(y)
+		work_  = ptrComplex128(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.zggglm_(&n_, &m_, &p_, a_, &lda_, b_, &ldb_, d_, x_, y_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZGGGLM", -info)
+	case info == 1:
+		return errNotFullRankMat("ZGGGLM", info, "A (cols)")
+	case info == 2:
+		return errNotFullRankMat("ZGGGLM", info, "[A, B] (rows)")
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("ZGGGLM", info))
+	}
+}
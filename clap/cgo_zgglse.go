@@ -0,0 +1,55 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGGLSE: complex double-precision Generalized General Least Squares with Equality constraints
+//
+// http://www.netlib.org/lapack/complex16/zgglse.f
+func zgglse(m, n, p int, a []complex128, lda int, b []complex128, ldb int, c, d, x []complex128) error {
+	// Query workspace size.
+	work := make([]complex128, 1)
+	err := zgglseHelper(m, n, p, a, lda, b, ldb, c, d, x, work, -1)
+	if err != nil {
+		return err
+	}
+
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	return zgglseHelper(m, n, p, a, lda, b, ldb, c, d, x, work, lwork)
+}
+
+func zgglseHelper(m, n, p int, a []complex128, lda int, b []complex128, ldb int, c, d, x, work []complex128, lwork int) error {
+	var (
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		p_     = C.integer(p)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		b_     = ptrComplex128(b)
+		ldb_   = C.integer(ldb)
+		c_     = ptrComplex128(c)
+		d_     = ptrComplex128(d)
+		x_     = ptrComplex128(x)
+		work_  = ptrComplex128(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.zgglse_(&m_, &n_, &p_, a_, &lda_, b_, &ldb_, c_, d_, x_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZGGLSE", -info)
+	case info == 1:
+		return errNotFullRankMat("ZGGLSE", info, "B (rows)")
+	case info == 2:
+		return errNotFullRankMat("ZGGLSE", info, "[A; B] (cols)")
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("ZGGLSE", info))
+	}
+}
@@ -0,0 +1,64 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZGGSVD3: complex double-precision Generalized General Singular Value Decomposition (blocked)
+//
+// http://www.netlib.org/lapack/complex16/zggsvd3.f
+func zggsvd3(m, n, p int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int) (k, l int, err error) {
+	rwork := make([]float64, 2*n)
+	iwork := make([]C.integer, n)
+
+	// Query workspace size.
+	work := make([]complex128, 1)
+	_, _, err = zggsvd3Helper(m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, -1, rwork, iwork)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	return zggsvd3Helper(m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, rwork, iwork)
+}
+
+func zggsvd3Helper(m, n, p int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []C.integer) (k, l int, err error) {
+	var (
+		jobu_  = C.char('U')
+		jobv_  = C.char('V')
+		jobq_  = C.char('Q')
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		p_     = C.integer(p)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		b_     = ptrComplex128(b)
+		ldb_   = C.integer(ldb)
+		alpha_ = ptrFloat64(alpha)
+		beta_  = ptrFloat64(beta)
+		u_     = ptrComplex128(u)
+		ldu_   = C.integer(ldu)
+		v_     = ptrComplex128(v)
+		ldv_   = C.integer(ldv)
+		q_     = ptrComplex128(q)
+		ldq_   = C.integer(ldq)
+		work_  = ptrComplex128(work)
+		lwork_ = C.integer(lwork)
+		rwork_ = ptrFloat64(rwork)
+		iwork_ = ptrInt(iwork)
+	)
+	var k_, l_, info_ C.integer
+
+	C.zggsvd3_(&jobu_, &jobv_, &jobq_, &m_, &n_, &p_, &k_, &l_, a_, &lda_, b_, &ldb_, alpha_, beta_, u_, &ldu_, v_, &ldv_, q_, &ldq_, work_, &lwork_, rwork_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, 0, errInvalidArg("ZGGSVD3", -info)
+	case info > 0:
+		return 0, 0, errFailConverge("ZGGSVD3", info)
+	default:
+		return int(k_), int(l_), nil
+	}
+}
@@ -2,29 +2,36 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zheev_
+// #cgo nocallback zheev_
 import "C"
 
 // ZHEEV: complex double-precision HErmitian EigenValues
 //
 // http://www.netlib.org/lapack/complex16/zheev.f
-func zheev(jobz jobzMode, uplo Triangle, n int, a []complex128, lda int) ([]float64, error) {
-	var err error
-	w := make([]float64, n)
-
+//
+// The workspace size is only queried once per problem size if ws is not nil,
+// in which case the eigenvalues are stored in its memory.
+func zheev(ws *Workspace, jobz jobzMode, uplo Triangle, n int, a []complex128, lda int) ([]float64, error) {
+	w := ws.floats("w", n)
 	lrwork := max(1, 3*n-2)
-	rwork := make([]float64, lrwork)
+	rwork := ws.floats("rwork", lrwork)
 
-	// Query workspace size.
-	work := make([]complex128, 1)
-	err = zheevHelper(jobz, uplo, n, a, lda, w, work, -1, rwork)
-	if err != nil {
-		return nil, err
+	key := workKey{routine: "zheev", job: [2]rune{rune(jobz)}, n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]complex128, 1)
+		if err := zheevHelper(jobz, uplo, n, a, lda, w, work, -1, rwork); err != nil {
+			return nil, err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
-	err = zheevHelper(jobz, uplo, n, a, lda, w, work, lwork, rwork)
-	if err != nil {
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
+	if err := zheevHelper(jobz, uplo, n, a, lda, w, work, lwork, rwork); err != nil {
 		return nil, err
 	}
 	return w, nil
@@ -49,9 +56,9 @@ func zheevHelper(jobz jobzMode, uplo Triangle, n int, a []complex128, lda int, w
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZHEEV", -info)
 	case info > 0:
-		return errOffDiagFailConverge(info)
+		return errOffDiagFailConverge("ZHEEV", info)
 	default:
 		return nil
 	}
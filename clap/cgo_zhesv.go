@@ -2,31 +2,40 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zhesv_
+// #cgo nocallback zhesv_
 import "C"
 
 // ZHESV: complex double-precision HErmitian SolVe
 //
 // http://www.netlib.org/lapack/complex16/zhesv.f
-func zhesv(n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
-	ipiv := make([]C.integer, n)
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func zhesv(ws *Workspace, uplo Triangle, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	ipiv := ws.ints("ipiv", n)
 
-	// Request workspace size.
-	work := make([]complex128, 1)
-	err := zhesvHelper(n, nrhs, a, lda, ipiv, b, ldb, work, -1)
-	if err != nil {
-		return err
+	key := workKey{routine: "zhesv", n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Request workspace size.
+		work := make([]complex128, 1)
+		if err := zhesvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
 	// Allocate workspace and make call.
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
-	return zhesvHelper(n, nrhs, a, lda, ipiv, b, ldb, work, lwork)
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
+	return zhesvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, lwork)
 }
 
 // Needs to be supplied ipiv and work.
-func zhesvHelper(n, nrhs int, a []complex128, lda int, ipiv []C.integer, b []complex128, ldb int, work []complex128, lwork int) error {
+func zhesvHelper(uplo Triangle, n, nrhs int, a []complex128, lda int, ipiv []C.integer, b []complex128, ldb int, work []complex128, lwork int) error {
 	var (
-		uplo_  = C.char(DefaultTri)
+		uplo_  = uploChar(uplo)
 		n_     = C.integer(n)
 		nrhs_  = C.integer(nrhs)
 		a_     = ptrComplex128(a)
@@ -44,9 +53,9 @@ func zhesvHelper(n, nrhs int, a []complex128, lda int, ipiv []C.integer, b []com
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZHESV", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("ZHESV", info)
 	default:
 		return nil
 	}
@@ -2,28 +2,41 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zhetrf_
+// #cgo nocallback zhetrf_
 import "C"
 
 // ZHETRF: complex double-precision HErmitian TRiangular Factor
 //
 // http://www.netlib.org/lapack/complex16/zhetrf.f
-func zhetrf(uplo Triangle, n int, a []complex128, lda int) (ipiv []int, err error) {
-	ipiv_ := make([]C.integer, n)
+//
+// The workspace size is only queried once per problem size if ws is not nil,
+// in which case the pivots are stored in its memory.
+func zhetrf(ws *Workspace, uplo Triangle, n int, a []complex128, lda int) (ipiv []int, err error) {
+	ipiv_ := ws.ints("ipiv", n)
 
-	// Query workspace size.
-	work := make([]complex128, 1)
-	err = zhetrfHelper(uplo, n, a, lda, ipiv_, work, -1)
-	if err != nil {
-		return nil, err
+	key := workKey{routine: "zhetrf", n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]complex128, 1)
+		if err := zhetrfHelper(uplo, n, a, lda, ipiv_, work, -1); err != nil {
+			return nil, err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
-	err = zhetrfHelper(uplo, n, a, lda, ipiv_, work, lwork)
-	if err != nil {
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
+	if err := zhetrfHelper(uplo, n, a, lda, ipiv_, work, lwork); err != nil {
 		return nil, err
 	}
-	return fromCInt(ipiv_), nil
+	ipiv = ws.pivots("ipiv", n)
+	for i, p := range ipiv_ {
+		ipiv[i] = int(p)
+	}
+	return ipiv, nil
 }
 
 func zhetrfHelper(uplo Triangle, n int, a []complex128, lda int, ipiv []C.integer, work []complex128, lwork int) error {
@@ -43,9 +56,9 @@ func zhetrfHelper(uplo Triangle, n int, a []complex128, lda int, ipiv []C.intege
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZHETRF", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("ZHETRF", info)
 	default:
 		return nil
 	}
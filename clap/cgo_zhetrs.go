@@ -29,10 +29,10 @@ func zhetrsHelper(uplo Triangle, n, nrhs int, a []complex128, lda int, ipiv []C.
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZHETRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("ZHETRS", info))
 	}
 }
@@ -0,0 +1,39 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZPOCON: complex double-precision POsitive-definite CONdition number
+//
+// http://www.netlib.org/lapack/complex16/zpocon.f
+//
+// Estimates the reciprocal condition number in the one-norm
+// given the Cholesky factorization and the one-norm of the original matrix.
+func zpocon(uplo Triangle, n int, a []complex128, lda int, anorm float64) (rcond float64, err error) {
+	var (
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		anorm_ = C.doublereal(anorm)
+		work_  = ptrComplex128(make([]complex128, 2*n))
+		rwork_ = ptrFloat64(make([]float64, n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.zpocon_(&uplo_, &n_, a_, &lda_, &anorm_, &rcond_, work_, rwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("ZPOCON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("ZPOCON", info))
+	}
+}
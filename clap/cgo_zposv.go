@@ -2,14 +2,16 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zposv_
+// #cgo nocallback zposv_
 import "C"
 
 // ZPOSV: complex double-precision POsitive-definite SolVe
 //
 // http://www.netlib.org/lapack/complex16/zposv.f
-func zposv(n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+func zposv(uplo Triangle, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
 	var (
-		uplo_ = C.char(DefaultTri)
+		uplo_ = uploChar(uplo)
 		n_    = C.integer(n)
 		nrhs_ = C.integer(nrhs)
 		a_    = ptrComplex128(a)
@@ -20,5 +22,5 @@ func zposv(n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error
 	var info_ C.integer
 
 	C.zposv_(&uplo_, &n_, &nrhs_, a_, &lda_, b_, &ldb_, &info_)
-	return zpotrfError(int(info_))
+	return zpotrfError("ZPOSV", int(info_))
 }
@@ -17,15 +17,15 @@ func zpotrf(uplo Triangle, n int, a []complex128, lda int) error {
 	var info_ C.integer
 
 	C.zpotrf_(&uplo_, &n_, a_, &lda_, &info_)
-	return zpotrfError(int(info_))
+	return zpotrfError("ZPOTRF", int(info_))
 }
 
-func zpotrfError(info int) error {
+func zpotrfError(routine string, info int) error {
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg(routine, -info)
 	case info > 0:
-		return errNotPosDef(info)
+		return errNotPosDef(routine, info)
 	default:
 		return nil
 	}
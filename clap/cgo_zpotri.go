@@ -21,10 +21,10 @@ func zpotri(uplo Triangle, n int, a []complex128, lda int) error {
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZPOTRI", -info)
 	case info == 0:
 		return nil
 	default:
-		return errNotPosDef(info)
+		return errNotPosDef("ZPOTRI", info)
 	}
 }
@@ -24,10 +24,10 @@ func zpotrs(uplo Triangle, n, nrhs int, a []complex128, lda int, b []complex128,
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZPOTRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("ZPOTRS", info))
 	}
 }
@@ -0,0 +1,53 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZSYSV: complex double-precision SYmmetric SolVe
+//
+// http://www.netlib.org/lapack/complex16/zsysv.f
+func zsysv(uplo Triangle, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	ipiv := make([]C.integer, n)
+
+	// Request workspace size.
+	work := make([]complex128, 1)
+	err := zsysvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, -1)
+	if err != nil {
+		return err
+	}
+
+	// Allocate workspace and make call.
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	return zsysvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, lwork)
+}
+
+// Needs to be supplied ipiv and work.
+func zsysvHelper(uplo Triangle, n, nrhs int, a []complex128, lda int, ipiv []C.integer, b []complex128, ldb int, work []complex128, lwork int) error {
+	var (
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		ipiv_  = ptrInt(ipiv)
+		b_     = ptrComplex128(b)
+		ldb_   = C.integer(ldb)
+		work_  = ptrComplex128(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.zsysv_(&uplo_, &n_, &nrhs_, a_, &lda_, ipiv_, b_, &ldb_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZSYSV", -info)
+	case info > 0:
+		return errSingular("ZSYSV", info)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,52 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZSYTRF: complex double-precision SYmmetric TRiangular Factor
+//
+// http://www.netlib.org/lapack/complex16/zsytrf.f
+func zsytrf(uplo Triangle, n int, a []complex128, lda int) (ipiv []int, err error) {
+	ipiv_ := make([]C.integer, n)
+
+	// Query workspace size.
+	work := make([]complex128, 1)
+	err = zsytrfHelper(uplo, n, a, lda, ipiv_, work, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	lwork := int(real(work[0]))
+	work = make([]complex128, max(1, lwork))
+	err = zsytrfHelper(uplo, n, a, lda, ipiv_, work, lwork)
+	if err != nil {
+		return nil, err
+	}
+	return fromCInt(ipiv_), nil
+}
+
+func zsytrfHelper(uplo Triangle, n int, a []complex128, lda int, ipiv []C.integer, work []complex128, lwork int) error {
+	var (
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		ipiv_  = ptrInt(ipiv)
+		work_  = ptrComplex128(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.zsytrf_(&uplo_, &n_, a_, &lda_, ipiv_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("ZSYTRF", -info)
+	case info > 0:
+		return errSingular("ZSYTRF", info)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package clap
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// ZTRCON: complex double-precision TRiangular CONdition number
+//
+// http://www.netlib.org/lapack/complex16/ztrcon.f
+//
+// Estimates the reciprocal condition number in the one-norm.
+func ztrcon(tri Triangle, diag diagType, n int, a []complex128, lda int) (rcond float64, err error) {
+	var (
+		norm_  = C.char('1')
+		uplo_  = uploChar(tri)
+		diag_  = diagChar(diag)
+		n_     = C.integer(n)
+		a_     = ptrComplex128(a)
+		lda_   = C.integer(lda)
+		work_  = ptrComplex128(make([]complex128, 2*n))
+		rwork_ = ptrFloat64(make([]float64, n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.ztrcon_(&norm_, &uplo_, &diag_, &n_, a_, &lda_, &rcond_, work_, rwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("ZTRCON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("ZTRCON", info))
+	}
+}
@@ -2,6 +2,8 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape ztrtrs_
+// #cgo nocallback ztrtrs_
 import "C"
 
 // DTRTRS: complex double-precision TRiangular Solve
@@ -26,9 +28,9 @@ func ztrtrs(tri Triangle, h bool, diag diagType, n, nrhs int, a []complex128, ld
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZTRTRS", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("ZTRTRS", info)
 	default:
 		return nil
 	}
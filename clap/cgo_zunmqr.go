@@ -2,21 +2,30 @@ package clap
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape zunmqr_
+// #cgo nocallback zunmqr_
 import "C"
 
 // ZUNMQR: complex double-precision UNitary Multiply by QR
 //
 // http://www.netlib.org/lapack/complex16/zunmqr.f
-func zunmqr(side matSide, h bool, m, n, k int, a []complex128, lda int, tau []complex128, c []complex128, ldc int) error {
-	// Query for workspace size.
-	work := make([]complex128, 1)
-	err := zunmqrHelper(side, h, m, n, k, a, lda, tau, c, ldc, work, -1)
-	if err != nil {
-		return err
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func zunmqr(ws *Workspace, side matSide, h bool, m, n, k int, a []complex128, lda int, tau []complex128, c []complex128, ldc int) error {
+	key := workKey{routine: "zunmqr", job: [2]rune{rune(side)}, m: m, n: n, k: k}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query for workspace size.
+		work := make([]complex128, 1)
+		if err := zunmqrHelper(side, h, m, n, k, a, lda, tau, c, ldc, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(real(work[0]))}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(real(work[0]))
-	work = make([]complex128, max(1, lwork))
+	lwork := size.lwork
+	work := ws.complexes("work", max(1, lwork))
 	return zunmqrHelper(side, h, m, n, k, a, lda, tau, c, ldc, work, lwork)
 }
 
@@ -42,10 +51,10 @@ func zunmqrHelper(side matSide, h bool, m, n, k int, a []complex128, lda int, ta
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("ZUNMQR", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("ZUNMQR", info))
 	}
 }
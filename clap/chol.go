@@ -13,16 +13,27 @@ type CholFact struct {
 // Calls ZPOTRF.
 // Equivalent to SolvePosDef (calls ZPOSV).
 func Chol(a Const) (*CholFact, error) {
+	return CholOpts(a, nil)
+}
+
+// CholOpts is like Chol but takes options.
+func CholOpts(a Const, opts *Opts) (*CholFact, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, err
 	}
-	if err := errNonHerm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
 		return nil, err
 	}
-	return chol(cloneMat(a), DefaultTri)
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, err
+	}
+	return chol(cloneMat(a), opts.tri())
 }
 
 // a will be modified.
@@ -41,7 +52,10 @@ func (chol *CholFact) Solve(b []complex128) ([]complex128, error) {
 	if err := errIncompat(chol.A, b); err != nil {
 		return nil, err
 	}
-	return chol.solve(cloneSlice(b))
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(chol.solve(cloneSlice(b)))
 }
 
 // b will be modified.
@@ -62,6 +76,7 @@ func (chol *CholFact) Inv() (*Mat, error) {
 	if err := zpotri(chol.Tri, n, a.Elems, n); err != nil {
 		return nil, err
 	}
-	cmat.Copy(a, &triangleMat{*a, chol.Tri})
-	return a, nil
+	// Fill in the other triangle.
+	cmat.Copy(a, &Hermitian{a, chol.Tri})
+	return finiteResultMat(a, nil)
 }
@@ -2394,6 +2394,13 @@ doublereal clantr_(char *norm, char *uplo, char *diag, integer *m, integer *n,
 	*ldv, doublereal *q, integer *ldq, doublereal *work, integer *iwork, 
 	integer *info);
 
+/* Subroutine */ int dggsvd3_(char *jobu, char *jobv, char *jobq, integer *m, 
+	integer *n, integer *p, integer *k, integer *l, doublereal *a, 
+	integer *lda, doublereal *b, integer *ldb, doublereal *alpha, 
+	doublereal *beta, doublereal *u, integer *ldu, doublereal *v, integer 
+	*ldv, doublereal *q, integer *ldq, doublereal *work, integer *lwork, 
+	integer *iwork, integer *info);
+
 /* Subroutine */ int dggsvp_(char *jobu, char *jobv, char *jobq, integer *m, 
 	integer *p, integer *n, doublereal *a, integer *lda, doublereal *b, 
 	integer *ldb, doublereal *tola, doublereal *tolb, integer *k, integer 
@@ -5824,6 +5831,13 @@ doublereal slapy3_(real *x, real *y, real *z__);
 	integer *ldv, doublecomplex *q, integer *ldq, doublecomplex *work, 
 	doublereal *rwork, integer *iwork, integer *info);
 
+/* Subroutine */ int zggsvd3_(char *jobu, char *jobv, char *jobq, integer *m, 
+	integer *n, integer *p, integer *k, integer *l, doublecomplex *a, 
+	integer *lda, doublecomplex *b, integer *ldb, doublereal *alpha, 
+	doublereal *beta, doublecomplex *u, integer *ldu, doublecomplex *v, 
+	integer *ldv, doublecomplex *q, integer *ldq, doublecomplex *work, 
+	integer *lwork, doublereal *rwork, integer *iwork, integer *info);
+
 /* Subroutine */ int zggsvp_(char *jobu, char *jobv, char *jobq, integer *m, 
 	integer *p, integer *n, doublecomplex *a, integer *lda, doublecomplex 
 	*b, integer *ldb, doublereal *tola, doublereal *tolb, integer *k, 
@@ -0,0 +1,109 @@
+package clap
+
+// Solves A x = b where A is complex symmetric (A' = A without conjugation).
+// Calls ZSYSV.
+func SolveComplexSymm(a Const, b []complex128) ([]complex128, error) {
+	return SolveComplexSymmOpts(a, b, nil)
+}
+
+// SolveComplexSymmOpts is like SolveComplexSymm but takes options.
+func SolveComplexSymmOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveComplexSymm(cloneMat(a), cloneSlice(b), opts.tri()))
+}
+
+// a and b will be modified.
+func solveComplexSymm(a *Mat, b []complex128, tri Triangle) ([]complex128, error) {
+	n, _ := a.Dims()
+	err := zsysv(tri, n, 1, a.Elems, n, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Describes an LDL' factorization of a complex symmetric matrix.
+type LDLComplexSymmFact struct {
+	A   *Mat
+	Tri Triangle
+	Piv []int
+}
+
+// Computes an LDL' factorization of a complex symmetric matrix.
+// Calls ZSYTRF.
+// Equivalent to SolveComplexSymm (calls ZSYSV).
+func LDLComplexSymm(a Const) (*LDLComplexSymmFact, error) {
+	return LDLComplexSymmOpts(a, nil)
+}
+
+// LDLComplexSymmOpts is like LDLComplexSymm but takes options.
+func LDLComplexSymmOpts(a Const, opts *Opts) (*LDLComplexSymmFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return ldlComplexSymm(cloneMat(a), opts.tri())
+}
+
+// a will be modified.
+func ldlComplexSymm(a *Mat, tri Triangle) (*LDLComplexSymmFact, error) {
+	n, _ := a.Dims()
+	piv, err := zsytrf(tri, n, a.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	return &LDLComplexSymmFact{a, tri, piv}, nil
+}
+
+// Solves a square, complex symmetric system given its LDL' factorization.
+// Calls ZSYTRS.
+func (ldl *LDLComplexSymmFact) Solve(b []complex128) ([]complex128, error) {
+	if err := errIncompat(ldl.A, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(ldl.solve(cloneSlice(b)))
+}
+
+// b will be modified.
+func (ldl *LDLComplexSymmFact) solve(b []complex128) ([]complex128, error) {
+	n, _ := ldl.A.Dims()
+	err := zsytrs(ldl.Tri, n, 1, ldl.A.Elems, n, ldl.Piv, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
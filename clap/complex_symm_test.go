@@ -0,0 +1,69 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestSolveComplexSymm(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.T(a))
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	got, err := SolveComplexSymm(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveComplexSymm_hermitian(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.H(a))
+	// A Hermitian matrix with complex off-diagonal elements is not symmetric.
+	if _, err := SolveComplexSymm(a, randVec(n)); err == nil {
+		t.Fatal("expected error for non-symmetric matrix")
+	}
+}
+
+func TestSolveComplexSymmOpts_noSymmCheck(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.T(a))
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	// The Hermitian check does not apply to complex symmetric matrices.
+	if _, err := SolveComplexSymmOpts(spoilUpper(a), b, &Opts{Tri: LowerTri, NoHermCheck: true}); err == nil {
+		t.Fatal("expected error for non-symmetric matrix")
+	}
+	got, err := SolveComplexSymmOpts(spoilUpper(a), b, &Opts{Tri: LowerTri, NoSymmCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestLDLComplexSymmFact_Solve(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.T(a))
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		ldl, err := LDLComplexSymmOpts(a, &Opts{Tri: tri})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ldl.Solve(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
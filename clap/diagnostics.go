@@ -0,0 +1,252 @@
+package clap
+
+import (
+	"github.com/jvlmdr/lin-go/cmat"
+	"github.com/jvlmdr/lin-go/zvec"
+)
+
+// Diagnostics describes the accuracy of a solution x to A x = b.
+type Diagnostics struct {
+	// Residual r = b - A x.
+	Resid []complex128
+	// Relative residual ||r|| / ||b|| in the two-norm.
+	RelResid float64
+	// Normwise backward error ||r|| / (||A|| ||x|| + ||b||) in the infinity-norm.
+	BackwardErr float64
+	// Estimate of the reciprocal condition number of A in the one-norm.
+	// For SolveDiagnostics, the ratio of the smallest to the largest singular value.
+	RCond float64
+}
+
+// SolveSquareDiagnostics is like SolveSquare but also returns diagnostics.
+// Calls ZGETRF, ZGECON and ZGETRS.
+func SolveSquareDiagnostics(a Const, b []complex128) ([]complex128, *Diagnostics, error) {
+	return SolveSquareDiagnosticsOpts(a, b, nil)
+}
+
+// SolveSquareDiagnosticsOpts is like SolveSquareDiagnostics but takes options.
+func SolveSquareDiagnosticsOpts(a Const, b []complex128, opts *Opts) ([]complex128, *Diagnostics, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, nil, err
+	}
+	x, rcond, err := solveSquareCond(cloneMat(a), cloneSlice(b), cmat.Norm(a, cmat.OneNorm))
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, nil, err
+	}
+	return x, diagnose(a, b, x, rcond), nil
+}
+
+// a and b will be modified.
+func solveSquareCond(a *Mat, b []complex128, anorm float64) ([]complex128, float64, error) {
+	n, _ := a.Dims()
+	fact, err := lu(a)
+	if err != nil {
+		return nil, 0, err
+	}
+	rcond, err := zgecon(n, a.Elems, n, anorm)
+	if err != nil {
+		return nil, 0, err
+	}
+	x, err := fact.solve(false, b)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, rcond, nil
+}
+
+// SolvePosDefDiagnostics is like SolvePosDef but also returns diagnostics.
+// Calls ZPOTRF, ZPOCON and ZPOTRS.
+func SolvePosDefDiagnostics(a Const, b []complex128) ([]complex128, *Diagnostics, error) {
+	return SolvePosDefDiagnosticsOpts(a, b, nil)
+}
+
+// SolvePosDefDiagnosticsOpts is like SolvePosDefDiagnostics but takes options.
+func SolvePosDefDiagnosticsOpts(a Const, b []complex128, opts *Opts) ([]complex128, *Diagnostics, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, nil, err
+	}
+	// Only the triangle Tri is solved.
+	herm := &Hermitian{cloneMat(a), opts.tri()}
+	x, rcond, err := solvePosDefCond(cloneMat(a), cloneSlice(b), opts.tri(), cmat.Norm(herm, cmat.OneNorm))
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, nil, err
+	}
+	return x, diagnose(herm, b, x, rcond), nil
+}
+
+// a and b will be modified.
+func solvePosDefCond(a *Mat, b []complex128, tri Triangle, anorm float64) ([]complex128, float64, error) {
+	n, _ := a.Dims()
+	fact, err := chol(a, tri)
+	if err != nil {
+		return nil, 0, err
+	}
+	rcond, err := zpocon(tri, n, a.Elems, n, anorm)
+	if err != nil {
+		return nil, 0, err
+	}
+	x, err := fact.solve(b)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, rcond, nil
+}
+
+// SolveFullRankDiagnostics is like SolveFullRank but also returns diagnostics.
+// The condition number is estimated from the triangular factor
+// of the QR or LQ factorization.
+// Calls ZGELS and ZTRCON.
+func SolveFullRankDiagnostics(a Const, b []complex128) ([]complex128, *Diagnostics, error) {
+	return SolveFullRankDiagnosticsOpts(a, b, nil)
+}
+
+// SolveFullRankDiagnosticsOpts is like SolveFullRankDiagnostics but takes options.
+func SolveFullRankDiagnosticsOpts(a Const, b []complex128, opts *Opts) ([]complex128, *Diagnostics, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, nil, err
+	}
+	m, n := a.Dims()
+	x, rcond, err := solveFullRankCond(cloneMat(a), cloneSliceCap(b, max(m, n)))
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, nil, err
+	}
+	return x, diagnose(a, b, x, rcond), nil
+}
+
+// a and b will be modified.
+// b must have capacity for solution.
+func solveFullRankCond(a *Mat, b []complex128) ([]complex128, float64, error) {
+	m, n := a.Dims()
+	x, err := solveFullRank(a, b)
+	if err != nil {
+		return nil, 0, err
+	}
+	// R is upper triangular if m >= n, L is lower triangular if m < n.
+	tri := UpperTri
+	if m < n {
+		tri = LowerTri
+	}
+	rcond, err := ztrcon(tri, nonUnitDiag, min(m, n), a.Elems, m)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, rcond, nil
+}
+
+// SolveDiagnostics is like Solve but also returns diagnostics.
+func SolveDiagnostics(a Const, b []complex128) ([]complex128, *Diagnostics, error) {
+	return SolveEpsDiagnostics(a, b, DefaultEps)
+}
+
+// SolveDiagnosticsOpts is like SolveOpts but also returns diagnostics.
+func SolveDiagnosticsOpts(a Const, b []complex128, opts *Opts) ([]complex128, *Diagnostics, error) {
+	return solveEpsDiagnosticsOpts(a, b, opts.eps(), opts)
+}
+
+// SolveEpsDiagnostics is like SolveEps but also returns diagnostics.
+// The reciprocal condition number is computed exactly from the singular values.
+// Calls ZGELSD.
+func SolveEpsDiagnostics(a Const, b []complex128, eps float64) ([]complex128, *Diagnostics, error) {
+	return solveEpsDiagnosticsOpts(a, b, eps, nil)
+}
+
+func solveEpsDiagnosticsOpts(a Const, b []complex128, eps float64, opts *Opts) ([]complex128, *Diagnostics, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, nil, err
+	}
+	m, n := a.Dims()
+	x, rcond, err := solveEpsCond(cloneMat(a), cloneSliceCap(b, max(m, n)), eps)
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, nil, err
+	}
+	return x, diagnose(a, b, x, rcond), nil
+}
+
+// a and b will be modified.
+// b must have capacity for solution.
+func solveEpsCond(a *Mat, b []complex128, eps float64) ([]complex128, float64, error) {
+	m, n := a.Dims()
+	b = b[:max(m, n)]
+	s, err := zgelsd(nil, m, n, 1, a.Elems, m, b, len(b), eps)
+	if err != nil {
+		return nil, 0, err
+	}
+	return b[:n], ratio(s[len(s)-1], s[0]), nil
+}
+
+// Computes the residual and backward error of x.
+func diagnose(a Const, b, x []complex128, rcond float64) *Diagnostics {
+	ax := cmat.MulVec(a, x)
+	r := make([]complex128, len(b))
+	for i := range r {
+		r[i] = b[i] - ax[i]
+	}
+
+	anorm := cmat.Norm(a, cmat.InfNorm)
+	rinf := zvec.InfNorm(zvec.Slice(r))
+	xinf := zvec.InfNorm(zvec.Slice(x))
+	binf := zvec.InfNorm(zvec.Slice(b))
+
+	return &Diagnostics{
+		Resid:       r,
+		RelResid:    ratio(zvec.Norm(zvec.Slice(r)), zvec.Norm(zvec.Slice(b))),
+		BackwardErr: ratio(rinf, anorm*xinf+binf),
+		RCond:       rcond,
+	}
+}
+
+// Returns p / q, or zero if p is zero.
+func ratio(p, q float64) float64 {
+	if p == 0 {
+		return 0
+	}
+	return p / q
+}
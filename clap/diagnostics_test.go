@@ -0,0 +1,120 @@
+package clap
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func testDiagnostics(t *testing.T, a Const, b, x []complex128, diag *Diagnostics) {
+	ax := cmat.MulVec(a, x)
+	r := make([]complex128, len(b))
+	for i := range r {
+		r[i] = b[i] - ax[i]
+	}
+	testSliceEq(t, r, diag.Resid)
+	if diag.BackwardErr < 0 || diag.BackwardErr > 1e-12 {
+		t.Errorf("backward error: want small, got %.4g", diag.BackwardErr)
+	}
+	if diag.RCond <= 0 || diag.RCond > 1 {
+		t.Errorf("rcond: want in (0, 1], got %.4g", diag.RCond)
+	}
+}
+
+func TestSolveSquareDiagnostics(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	got, diag, err := SolveSquareDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+}
+
+func TestSolvePosDefDiagnostics(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = cmat.Mul(cmat.H(a), a)
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	got, diag, err := SolvePosDefDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+}
+
+func TestSolvePosDefDiagnosticsOpts_otherTri(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = cmat.Mul(cmat.H(a), a)
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+	// Fill the upper triangle with garbage.
+	g := cmat.New(n, n)
+	cmat.Copy(g, a)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.Set(i, j, 1e6-1e6i)
+		}
+	}
+
+	opts := &Opts{Tri: LowerTri, NoHermCheck: true}
+	got, diag, err := SolvePosDefDiagnosticsOpts(g, b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+	if diag.RelResid > 1e-9 {
+		t.Errorf("relative residual: want small, got %.4g", diag.RelResid)
+	}
+}
+
+func TestSolveFullRankDiagnostics(t *testing.T) {
+	m, n := 150, 100
+	a, b, want, err := overDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, diag, err := SolveFullRankDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	ax := cmat.MulVec(a, got)
+	for i := range ax {
+		ax[i] = b[i] - ax[i]
+	}
+	testSliceEq(t, ax, diag.Resid)
+	if diag.RCond <= 0 || diag.RCond > 1 {
+		t.Errorf("rcond: want in (0, 1], got %.4g", diag.RCond)
+	}
+}
+
+func TestSolveDiagnostics(t *testing.T) {
+	a := cmat.NewRows([][]complex128{
+		{4i, 0, 0},
+		{0, -2, 0},
+		{0, 0, 0.5},
+	})
+	b := []complex128{4, 2i, 1}
+
+	got, diag, err := SolveDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, []complex128{-1i, -1i, 2}, got)
+	testDiagnostics(t, a, b, got, diag)
+	if want := 0.5 / 4; math.Abs(diag.RCond-want) > 1e-12 {
+		t.Errorf("rcond: want %.6g, got %.6g", want, diag.RCond)
+	}
+}
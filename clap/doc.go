@@ -4,17 +4,73 @@ Package clap links to native library for complex linear algebra.
 Uses the following routines to solve linear systems:
 	SolveEps         zgelsd    SVD      general matrix (threshold singular values)
 	SolveFullRank    zgels     QR/LQ    full-rank matrix (min norm or min residual)
+	SolveLSE         zgglse    GRQ      least squares with equality constraints
+	SolveGLM         zggglm    GQR      general Gauss-Markov linear model
 	SolveSquare      zgesv     LU       full-rank, square matrix
 	SolveHerm        zhesv     LDL      full-rank, square, Hermitian matrix
+	SolveComplexSymm zsysv     LDL      full-rank, square, complex symmetric matrix
 	SolvePosDef      zposv     Chol     full-rank, square, Hermitian, positive-definite matrix
 and provides access to the following routines for computing and using decompositions:
 	LU      zgetrf zgetrs
 	QR      zgeqrf zunmqr ztrtrs
+	LQ      zgelqf zunmlq ztrtrs
 	Chol    zpotrf zpotrs
-	LDL     zsytrf zsytrs
+	LDL     zhetrf zhetrs
+	LDLComplexSymm zsytrf zsytrs
 	SVD     zgesdd
+	GSVD    zggsvd3
 	Eig     zheev zgeev
 
+Polar computes the polar decomposition from the SVD (zgesdd).
+
+Nonsymmetric matrices can be balanced (Balance, zgebal zgebak).
+EigOpts (zgeevx) controls the balancing used to compute eigenvalues
+and EigExpert (zgeevx) also returns their reciprocal condition numbers.
+
+Failures reported by LAPACK are returned as *laerr.Error,
+which records the routine, info code and index
+and can be tested with errors.Is against the sentinel errors in package laerr
+(e.g. laerr.ErrSingular, laerr.ErrNotPosDef).
+
+Matrices and vectors are checked for NaN and Inf before calling LAPACK.
+The results which are checked afterwards are
+the solutions of linear systems,
+the inverse from CholFact.Inv
+and the vectors from BalanceFact.BackTransform.
+Factorizations, eigenvalues and singular values are not checked.
+Only the elements which LAPACK reads are checked:
+one triangle of a Hermitian or complex symmetric matrix.
+An element which is not finite is reported as *laerr.NonFiniteError
+(errors.Is(err, laerr.ErrNonFinite)).
+Opts.NoFiniteCheck skips the check in one call.
+
+The variants with the suffix Diagnostics of SolveSquare, SolvePosDef,
+SolveFullRank, Solve and SolveEps also return the residual, the relative residual,
+the normwise backward error and an estimate of the reciprocal condition number
+(zgecon, zpocon, ztrcon or the singular values from zgelsd).
+
+The package-level defaults (DefaultTri, DefaultEps and the tolerances
+of the Hermitian and symmetric checks) can be overridden per call with Opts
+using the variants with the suffix Opts of
+Chol, LDL, EigHerm, SolveHerm, SolvePosDef, Solve, Eig, SolveSquare,
+SolveComplexSymm, LDLComplexSymm, the Diagnostics and InPlace variants
+and the methods of Workspace.
+The other functions always check for NaN and Inf.
+
+Most solvers and decompositions have a variant with the suffix InPlace
+(e.g. SolveSquareInPlace, CholInPlace) which takes ownership of a *Mat
+and overwrites it and the right-hand side instead of copying them.
+
+A Workspace caches workspace queries and memory between calls
+to avoid allocating in loops over problems of the same size.
+It provides SolveFullRank, Solve, SolveEps, SolveSquare, SolveHerm, SolvePosDef,
+LDL, QRSolve, SVD, EigHerm and Eig and their variants with the suffix Opts,
+which do not allocate on repeated calls
+(except EigOpts with a balancing other than BalanceBoth).
+
+A Hermitian matrix is a view of one triangle of a full n x n matrix
+and is not checked by the functions which require a Hermitian matrix.
+
 No support for banded or triangular matrices.
 No support for packed representations.
 */
@@ -3,21 +3,32 @@ package clap
 // Computes the eigenvalue factorization of a Hermitian matrix.
 // Calls ZHEEV.
 func EigHerm(a Const) (*Mat, []float64, error) {
+	return EigHermOpts(a, nil)
+}
+
+// EigHermOpts is like EigHerm but takes options.
+func EigHermOpts(a Const, opts *Opts) (*Mat, []float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, nil, err
 	}
-	if err := errNonHerm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
 		return nil, nil, err
 	}
-	return eigHerm(cloneMat(a), DefaultTri)
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, nil, err
+	}
+	return eigHerm(cloneMat(a), opts.tri())
 }
 
 func eigHerm(a *Mat, tri Triangle) (*Mat, []float64, error) {
 	n, _ := a.Dims()
-	d, err := zheev(vectors, UpperTri, n, a.Elems, n)
+	d, err := zheev(nil, vectors, tri, n, a.Elems, n)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -33,15 +44,43 @@ func Eig(a Const) (*Mat, []complex128, error) {
 	if err := errNonSquare(a); err != nil {
 		return nil, nil, err
 	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
 	return eig(cloneMat(a))
 }
 
+// EigOpts is like Eig but takes options.
+// Calls ZGEEVX.
+func EigOpts(a Const, opts *Opts) (*Mat, []complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errBadBalance(opts.balance()); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	n, _ := a.Dims()
+	v := NewMat(n, n)
+	r, err := zgeevx(opts.balance(), false, n, cloneMat(a).Elems, n, nil, 1, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, r.w, nil
+}
+
 func eig(a *Mat) (*Mat, []complex128, error) {
 	n, _ := a.Dims()
 	v := NewMat(n, n)
-	d, err := zgeev(vectors, values, n, a.Elems, n, v.Elems, n, nil, 0)
+	// Compute right eigenvectors only.
+	d, err := zgeev(nil, values, vectors, n, a.Elems, n, nil, 1, v.Elems, n)
 	if err != nil {
 		return nil, nil, err
 	}
-	return a, d, nil
+	return v, d, nil
 }
@@ -0,0 +1,59 @@
+package clap
+
+// EigExpertFact describes the eigenvalues and eigenvectors of a square matrix
+// with estimates of their sensitivity.
+type EigExpertFact struct {
+	Values []complex128
+	// Left and right eigenvectors, normalized to unit norm.
+	VL, VR *Mat
+	// Reciprocal condition numbers of each eigenvalue and right eigenvector.
+	// The error in Values[j] is about eps * ABNorm / RCondE[j]
+	// and the angular error in its eigenvector is about eps * ABNorm / RCondV[j].
+	RCondE, RCondV []float64
+	// Balancing which was applied (see BalanceFact).
+	ILo, IHi int
+	Scale    []float64
+	// One-norm of the balanced matrix.
+	ABNorm float64
+}
+
+// EigExpert computes the eigenvalues and left and right eigenvectors
+// of a square matrix after balancing it,
+// as well as the reciprocal condition numbers of the eigenvalues and right eigenvectors.
+// Calls ZGEEVX.
+func EigExpert(a Const, job BalanceJob) (*EigExpertFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errBadBalance(job); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return eigExpert(cloneMat(a), job)
+}
+
+// a will be modified.
+func eigExpert(a *Mat, job BalanceJob) (*EigExpertFact, error) {
+	n, _ := a.Dims()
+	vl, vr := NewMat(n, n), NewMat(n, n)
+	r, err := zgeevx(job, true, n, a.Elems, n, vl.Elems, n, vr.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	return &EigExpertFact{
+		Values: r.w,
+		VL:     vl,
+		VR:     vr,
+		RCondE: r.rconde,
+		RCondV: r.rcondv,
+		ILo:    r.ilo - 1,
+		IHi:    r.ihi - 1,
+		Scale:  r.scale,
+		ABNorm: r.abnrm,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package clap
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+// Checks that A x = lambda x relative to the norms of A and x.
+func testEigVec(t *testing.T, a Const, lambda complex128, x []complex128) {
+	var normX float64
+	for _, xi := range x {
+		normX = math.Max(normX, cmplx.Abs(xi))
+	}
+	tol := 1e-9 * cmat.Norm(a, cmat.OneNorm) * normX
+	ax := cmat.MulVec(a, x)
+	for i := range x {
+		if cmplx.Abs(ax[i]-lambda*x[i]) > tol {
+			t.Errorf("eigenvalue %v: at %d: want %v, got %v", lambda, i, lambda*x[i], ax[i])
+		}
+	}
+}
+
+func TestEigExpert(t *testing.T) {
+	n := 20
+	a := randMat(n, n)
+	f, err := EigExpert(a, BalanceBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ah := cmat.H(a)
+	for j, lambda := range f.Values {
+		testEigVec(t, a, lambda, cmat.Col(f.VR, j))
+		// Check that y^H A = lambda y^H.
+		testEigVec(t, ah, cmplx.Conj(lambda), cmat.Col(f.VL, j))
+		if !(f.RCondE[j] > 0 && f.RCondE[j] <= 1+1e-9) {
+			t.Errorf("eigenvalue %d: reciprocal condition number %g not in (0, 1]", j, f.RCondE[j])
+		}
+	}
+}
+
+func TestEigOpts_noBalance(t *testing.T) {
+	n := 20
+	a := randMat(n, n)
+	v, d, err := EigOpts(a, &Opts{Balance: NoBalance})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j, lambda := range d {
+		testEigVec(t, a, lambda, cmat.Col(v, j))
+	}
+}
+
+func TestBalance(t *testing.T) {
+	// Badly scaled matrix.
+	a := cmat.NewRows([][]complex128{
+		{1, 1e6i, 0},
+		{1e-6, 2, 1e6},
+		{0, 1e-6i, 3},
+	})
+	bal, err := Balance(a, BalanceBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmat.Norm(bal.A, cmat.OneNorm) >= cmat.Norm(a, cmat.OneNorm) {
+		t.Errorf("balancing did not reduce norm: %g", cmat.Norm(bal.A, cmat.OneNorm))
+	}
+	// Eigenvectors of the balanced matrix map to those of A.
+	v, d, err := EigOpts(bal.A, &Opts{Balance: NoBalance})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := bal.BackTransform(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j, lambda := range d {
+		testEigVec(t, a, lambda, cmat.Col(x, j))
+	}
+}
+
+func TestBalance_badJob(t *testing.T) {
+	a := randMat(3, 3)
+	if _, err := Balance(a, BalanceJob('X')); err == nil {
+		t.Error("Balance: expected error")
+	}
+	if _, err := EigExpert(a, BalanceJob('X')); err == nil {
+		t.Error("EigExpert: expected error")
+	}
+	if _, _, err := EigOpts(a, &Opts{Balance: BalanceJob('X')}); err == nil {
+		t.Error("EigOpts: expected error")
+	}
+}
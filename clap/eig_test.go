@@ -0,0 +1,20 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestEig(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+
+	v, d, err := Eig(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check that A V = V D.
+	testMatEq(t, cmat.Mul(a, v), cmat.Mul(v, cmat.NewDiag(d)))
+}
@@ -7,19 +7,34 @@ func Solve(a Const, b []complex128) ([]complex128, error) {
 	return SolveEps(a, b, DefaultEps)
 }
 
+// SolveOpts is like Solve but takes the tolerance from the options.
+func SolveOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	return solveEpsOpts(a, b, opts.eps(), opts)
+}
+
 // Solves A x = b.
 // The user must specify epsilon (inverse maximum condition number)
 // at which the line is drawn between equality constraints and residuals.
 // Calls ZGELSD (eps is the "rcond" parameter).
 func SolveEps(a Const, b []complex128, eps float64) ([]complex128, error) {
+	return solveEpsOpts(a, b, eps, nil)
+}
+
+func solveEpsOpts(a Const, b []complex128, eps float64, opts *Opts) ([]complex128, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
 	m, n := a.Dims()
-	return solveEps(cloneMat(a), cloneSliceCap(b, max(m, n)), eps)
+	return opts.finiteResult(solveEps(cloneMat(a), cloneSliceCap(b, max(m, n)), eps))
 }
 
 // a and b will be modified.
@@ -27,7 +42,7 @@ func SolveEps(a Const, b []complex128, eps float64) ([]complex128, error) {
 func solveEps(a *Mat, b []complex128, eps float64) ([]complex128, error) {
 	m, n := a.Dims()
 	b = b[:max(m, n)]
-	err := zgelsd(m, n, 1, a.Elems, m, b, len(b), eps)
+	_, err := zgelsd(nil, m, n, 1, a.Elems, m, b, len(b), eps)
 	if err != nil {
 		return nil, err
 	}
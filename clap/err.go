@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math"
 	"math/cmplx"
+
+	"github.com/jvlmdr/lin-go/laerr"
 )
 
-func errUnknown(info int) error {
-	return fmt.Errorf("lapack info non-zero: %d", info)
+func errUnknown(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrUnknown)
 }
 
 func errNonPosDims(a Const) error {
@@ -51,20 +53,46 @@ func errIncompatT(a Const, t bool, b []complex128) error {
 	return nil
 }
 
+func errIncompatMatT(a Const, t bool, b Const) error {
+	rows, cols := a.Dims()
+	if t {
+		rows, cols = cols, rows
+	}
+	p, q := b.Dims()
+	if rows != p {
+		return fmt.Errorf("incompatible: %dx%d and %dx%d", rows, cols, p, q)
+	}
+	return nil
+}
+
 var (
 	EpsHermAbs float64 = 1e-9
 	EpsHermRel float64 = 1e-9
 )
 
+// Tolerances of the check that a complex symmetric matrix is symmetric.
+var (
+	EpsSymmAbs float64 = 1e-9
+	EpsSymmRel float64 = 1e-9
+)
+
 // Returns an error if the matrix is not Hermitian.
 // Assumes that matrix is square.
 func errNonHerm(a Const) error {
+	return errNonHermEps(a, EpsHermAbs, EpsHermRel)
+}
+
+func errNonHermEps(a Const, epsAbs, epsRel float64) error {
+	// Hermitian by construction.
+	if _, ok := a.(*Hermitian); ok {
+		return nil
+	}
 	n, _ := a.Dims()
 	for i := 0; i < n; i++ {
 		for j := i; j < n; j++ {
 			ij, ji := a.At(i, j), a.At(j, i)
 			want, got := ij, cmplx.Conj(ji)
-			if !(eqEpsAbs(want, got, EpsHermAbs) || eqEpsRel(want, got, EpsHermRel)) {
+			if !(eqEpsAbs(want, got, epsAbs) || eqEpsRel(want, got, epsRel)) {
 				return fmt.Errorf("not Hermitian: at %d, %d: upper %g, lower %g", i, j, ij, ji)
 			}
 		}
@@ -72,6 +100,25 @@ func errNonHerm(a Const) error {
 	return nil
 }
 
+// Returns an error if the matrix is not symmetric (A' = A without conjugation).
+// Assumes that matrix is square.
+func errNonSymm(a Const) error {
+	return errNonSymmEps(a, EpsSymmAbs, EpsSymmRel)
+}
+
+func errNonSymmEps(a Const, epsAbs, epsRel float64) error {
+	n, _ := a.Dims()
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			ij, ji := a.At(i, j), a.At(j, i)
+			if !(eqEpsAbs(ij, ji, epsAbs) || eqEpsRel(ij, ji, epsRel)) {
+				return fmt.Errorf("not symmetric: at %d, %d: upper %g, lower %g", i, j, ij, ji)
+			}
+		}
+	}
+	return nil
+}
+
 func eqEpsAbs(a, b complex128, eps float64) bool {
 	if a == b {
 		return true
@@ -87,30 +134,170 @@ func eqEpsRel(a, b complex128, eps float64) bool {
 	return cmplx.Abs(a-b) <= eps*math.Max(cmplx.Abs(a), cmplx.Abs(b))
 }
 
-func errInvalidArg(arg int) error {
-	return fmt.Errorf("invalid arg: %d", arg)
+// arg is the one-based argument number (-info).
+func errInvalidArg(routine string, arg int) error {
+	return laerr.New(routine, -arg, arg-1, laerr.ErrInvalidArg)
 }
 
-func errSingular(index int) error {
-	return fmt.Errorf("exactly singular: at index %d", index)
+// index is one-based (info).
+func errSingular(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrSingular)
 }
 
-func errNotPosDef(index int) error {
-	return fmt.Errorf("matrix not pos def: at index %d", index)
+// index is one-based (info).
+func errNotPosDef(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrNotPosDef)
 }
 
-func errOffDiagFailConverge(index int) error {
-	return fmt.Errorf("off-diag elements failed to converge to zero: %d", index)
+// info is the number of off-diagonal elements which did not converge to zero.
+func errOffDiagFailConverge(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrFailConverge)
 }
 
 func errBadShape(m, n int) error {
 	return fmt.Errorf("invalid shape: %dx%d", m, n)
 }
 
-func errNotFullRank(index int) error {
-	return fmt.Errorf("not full rank: at index %d", index)
+// index is one-based (info).
+func errNotFullRank(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrNotFullRank)
+}
+
+func errFailConverge(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrFailConverge)
+}
+
+func errIncompatCols(a, b Const) error {
+	m, n := a.Dims()
+	p, q := b.Dims()
+	if n != q {
+		return fmt.Errorf("different number of columns: %dx%d and %dx%d", m, n, p, q)
+	}
+	return nil
+}
+
+func errBadShapeLSE(m, n, p int) error {
+	return fmt.Errorf("invalid shape: A %dx%d, B %dx%d: need p <= n <= m+p", m, n, p, n)
+}
+
+func errBadShapeGLM(n, m, p int) error {
+	return fmt.Errorf("invalid shape: A %dx%d, B %dx%d: need m <= n <= m+p", n, m, n, p)
 }
 
-func errFailConverge(info int) error {
-	return fmt.Errorf("did not converge: info %d", info)
+func errNotFullRankMat(routine string, info int, name string) error {
+	err := laerr.New(routine, info, -1, laerr.ErrNotFullRank)
+	err.Arg = name
+	return err
+}
+
+func errBadMat(a *Mat) error {
+	if len(a.Elems) != a.Rows*a.Cols {
+		return fmt.Errorf("matrix %dx%d has %d elements", a.Rows, a.Cols, len(a.Elems))
+	}
+	return nil
+}
+
+func errCap(b []complex128, n int) error {
+	if cap(b) < n {
+		return fmt.Errorf("insufficient capacity: need %d, have %d", n, cap(b))
+	}
+	return nil
+}
+
+func errBadTri(tri Triangle) error {
+	return fmt.Errorf("invalid triangle: %q", rune(tri))
+}
+
+// Returns an error if the balancing job is not valid.
+func errBadBalance(job BalanceJob) error {
+	switch job {
+	case NoBalance, BalancePermute, BalanceScale, BalanceBoth:
+		return nil
+	default:
+		return fmt.Errorf("invalid balance job: %q", rune(job))
+	}
+}
+
+func isFinite(v complex128) bool {
+	return !(cmplx.IsNaN(v) || cmplx.IsInf(v))
+}
+
+// Returns an error if an element of the matrix is not finite.
+func errNonFinite(a Const) error {
+	m, n := a.Dims()
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			if err := errNonFiniteAt("matrix", a, i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns an error if an element of a square matrix
+// in the given triangle is not finite.
+// The other triangle is not accessed.
+func errNonFiniteTri(a Const, tri Triangle) error {
+	n, _ := a.Dims()
+	for j := 0; j < n; j++ {
+		// Rows i0 <= i < i1 of column j are in the triangle.
+		i0, i1 := 0, j+1
+		if tri == LowerTri {
+			i0, i1 = j, n
+		}
+		for i := i0; i < i1; i++ {
+			if err := errNonFiniteAt("matrix", a, i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// name is "matrix" for an argument or "result" for a solution.
+func errNonFiniteAt(name string, a Const, i, j int) error {
+	if v := a.At(i, j); !isFinite(v) {
+		return &laerr.NonFiniteError{Arg: name, Row: i, Col: j, Value: v}
+	}
+	return nil
+}
+
+// Returns an error if an element of the vector is not finite.
+// name is "vector" for an argument or "result" for a solution.
+func errNonFiniteVec(name string, x []complex128) error {
+	for i, v := range x {
+		if !isFinite(v) {
+			return &laerr.NonFiniteError{Arg: name, Row: i, Col: -1, Value: v}
+		}
+	}
+	return nil
+}
+
+// Returns the result of a solver,
+// or an error if it failed or an element of x is not finite.
+// Used as finiteResult(solve(...)).
+func finiteResult(x []complex128, err error) ([]complex128, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("result", x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Like finiteResult for a matrix.
+func finiteResultMat(x *Mat, err error) (*Mat, error) {
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < x.Cols; j++ {
+		for i := 0; i < x.Rows; i++ {
+			if err := errNonFiniteAt("result", x, i, j); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return x, nil
 }
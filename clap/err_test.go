@@ -0,0 +1,98 @@
+package clap
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+	"github.com/jvlmdr/lin-go/laerr"
+)
+
+func TestSolveSquare_nonFinite(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	a.Set(2, 1, complex(1, math.NaN()))
+	b := randVec(n)
+
+	_, err := SolveSquare(a, b)
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.NonFiniteError, got %v", err)
+	}
+	if e.Arg != "matrix" || e.Row != 2 || e.Col != 1 {
+		t.Errorf("want matrix at 2, 1: got %s at %d, %d", e.Arg, e.Row, e.Col)
+	}
+}
+
+func TestSolveSquare_nonFiniteVec(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	b := randVec(n)
+	b[3] = complex(math.Inf(1), 0)
+
+	_, err := SolveSquare(a, b)
+	if !errors.Is(err, laerr.ErrNonFinite) {
+		t.Fatalf("expected laerr.ErrNonFinite, got %v", err)
+	}
+}
+
+func TestOpts_noFiniteCheck(t *testing.T) {
+	opts := &Opts{NoFiniteCheck: true}
+	a := randMat(3, 3)
+	a.Set(0, 0, complex(math.Inf(1), 0))
+	if err := opts.errNonFinite(a); err != nil {
+		t.Errorf("expected no error when disabled, got %v", err)
+	}
+	if err := opts.errNonFiniteVec([]complex128{complex(math.NaN(), 0)}); err != nil {
+		t.Errorf("expected no error when disabled, got %v", err)
+	}
+	if err := errNonFinite(a); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected laerr.ErrNonFinite without options, got %v", err)
+	}
+}
+
+func TestSolveSquareOpts_noFiniteCheck(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	b := randVec(n)
+	b[0] = complex(math.NaN(), 0)
+
+	if _, err := SolveSquare(a, b); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Fatalf("expected laerr.ErrNonFinite, got %v", err)
+	}
+	if _, err := SolveSquareOpts(a, b, &Opts{NoFiniteCheck: true}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSolveHermOpts_nonFiniteOtherTri(t *testing.T) {
+	n := 4
+	a := randMat(2*n, n)
+	a = cmat.Mul(cmat.H(a), a)
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+	// Elements outside the triangle are not read.
+	a.Set(0, n-1, complex(math.Inf(1), 0))
+
+	got, err := SolveHermOpts(a, b, &Opts{Tri: LowerTri, NoHermCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveHermOpts_noFiniteCheck(t *testing.T) {
+	n := 4
+	a := randMat(2*n, n)
+	a = cmat.Mul(cmat.H(a), a)
+	b := randVec(n)
+	b[1] = complex(math.NaN(), 0)
+
+	if _, err := SolveHermOpts(a, b, nil); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected laerr.ErrNonFinite, got %v", err)
+	}
+	if _, err := SolveHermOpts(a, b, &Opts{NoFiniteCheck: true}); errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected no check when disabled, got %v", err)
+	}
+}
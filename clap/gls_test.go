@@ -0,0 +1,84 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestSolveLSE(t *testing.T) {
+	m, n, p := 100, 40, 10
+	a := randMat(m, n)
+	c := randVec(m)
+	b := randMat(p, n)
+	d := randVec(p)
+
+	// Solve the KKT system
+	//   [A' A, B'; B, 0] [x; z] = [A' c; d].
+	k := cmat.Stack(
+		cmat.Augment(cmat.Mul(cmat.H(a), a), cmat.H(b)),
+		cmat.Augment(b, cmat.New(p, p)),
+	)
+	kkt, err := SolveHerm(k, append(cmat.MulVec(cmat.H(a), c), d...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := kkt[:n]
+
+	got, err := SolveLSE(a, c, b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	// Check that constraints are satisfied.
+	testSliceEq(t, d, cmat.MulVec(b, got))
+}
+
+func TestSolveLSE_badShape(t *testing.T) {
+	// More constraints than variables.
+	a := randMat(10, 4)
+	b := randMat(5, 4)
+	if _, err := SolveLSE(a, randVec(10), b, randVec(5)); err == nil {
+		t.Fatal("expected error for p > n")
+	}
+}
+
+func TestSolveGLM(t *testing.T) {
+	n, m := 100, 40
+	a := randMat(n, m)
+	b := randMat(n, n)
+	d := randVec(n)
+
+	// Weighted least squares: minimize ||B^-1 (d - A x)||.
+	// Z <- B \ A
+	z := cmat.New(n, m)
+	for j := 0; j < m; j++ {
+		zj, err := SolveSquare(b, cmat.Col(a, j))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmat.SetCol(z, j, zj)
+	}
+	// e <- B \ d
+	e, err := SolveSquare(b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := SolveFullRank(z, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, y, err := SolveGLM(a, b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, x)
+	// Check that d = A x + B y.
+	got := cmat.MulVec(a, x)
+	by := cmat.MulVec(b, y)
+	for i := range got {
+		got[i] += by[i]
+	}
+	testSliceEq(t, d, got)
+}
@@ -0,0 +1,39 @@
+package clap
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestGSVD(t *testing.T) {
+	testGSVD(t, randMat(15, 8), randMat(10, 8))
+}
+
+func TestGSVD_splitR(t *testing.T) {
+	// A has fewer rows than the rank of [A; B],
+	// so R is split between A and B.
+	testGSVD(t, randMat(4, 8), randMat(10, 8))
+}
+
+func testGSVD(t *testing.T, a, b *cmat.Mat) {
+	f, err := GSVD(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, n := a.Dims()
+	p, _ := b.Dims()
+	testMatEq(t, cmat.I(m), cmat.Mul(cmat.H(f.U), f.U))
+	testMatEq(t, cmat.I(p), cmat.Mul(cmat.H(f.V), f.V))
+	testMatEq(t, cmat.I(n), cmat.Mul(cmat.H(f.Q), f.Q))
+	for i := range f.Alpha {
+		if s := f.Alpha[i]*f.Alpha[i] + f.Beta[i]*f.Beta[i]; math.Abs(s-1) > 1e-9 {
+			t.Errorf("at %d: alpha^2 + beta^2 = %g", i, s)
+		}
+	}
+	// Check that A = U D1 [0, R] Q' and B = V D2 [0, R] Q'.
+	rqt := cmat.Mul(f.ZeroR(), cmat.H(f.Q))
+	testMatEq(t, a, cmat.Mul(f.U, cmat.Mul(f.D1(), rqt)))
+	testMatEq(t, b, cmat.Mul(f.V, cmat.Mul(f.D2(), rqt)))
+}
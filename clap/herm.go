@@ -3,6 +3,11 @@ package clap
 // Solves A x = b where A is Hermitian.
 // Calls ZHESV.
 func SolveHerm(a Const, b []complex128) ([]complex128, error) {
+	return SolveHermOpts(a, b, nil)
+}
+
+// SolveHermOpts is like SolveHerm but takes options.
+func SolveHermOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,16 +17,25 @@ func SolveHerm(a Const, b []complex128) ([]complex128, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	if err := errNonHerm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
 		return nil, err
 	}
-	return solveHerm(cloneMat(a), cloneSlice(b))
+	return opts.finiteResult(solveHerm(cloneMat(a), cloneSlice(b), opts.tri()))
 }
 
 // a and b will be modified.
-func solveHerm(a *Mat, b []complex128) ([]complex128, error) {
+func solveHerm(a *Mat, b []complex128, tri Triangle) ([]complex128, error) {
 	n, _ := a.Dims()
-	err := zhesv(n, 1, a.Elems, n, b, n)
+	err := zhesv(nil, tri, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
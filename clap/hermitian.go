@@ -0,0 +1,90 @@
+package clap
+
+import (
+	"fmt"
+	"math/cmplx"
+)
+
+// Hermitian is a view of one triangle of a square matrix as a Hermitian matrix.
+// Elements outside the triangle read as the conjugate of their transpose
+// and the imaginary part of the diagonal is ignored.
+//
+// The triangle is not stored compactly:
+// A is a full n x n matrix, which is passed to LAPACK without unpacking.
+// At and Set do not access the elements outside the triangle
+// and the functions which take a Hermitian do not read them,
+// so they may hold anything.
+//
+// Functions which require a Hermitian matrix
+// do not check a *Hermitian.
+type Hermitian struct {
+	// Square matrix whose elements outside the triangle are ignored.
+	A   *Mat
+	Tri Triangle
+}
+
+// Allocates a Hermitian matrix of all zeros.
+func NewHermitian(n int, tri Triangle) *Hermitian {
+	return &Hermitian{NewMat(n, n), tri}
+}
+
+// CopyHerm copies one triangle of a square matrix.
+// The other triangle is not accessed.
+func CopyHerm(a Const, tri Triangle) (*Hermitian, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	b := NewMat(n, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			if !otherTri(i, j, tri) {
+				b.Set(i, j, a.At(i, j))
+			}
+		}
+	}
+	return &Hermitian{b, tri}, nil
+}
+
+func (a *Hermitian) Dims() (rows, cols int) {
+	return a.A.Dims()
+}
+
+func (a *Hermitian) At(i, j int) complex128 {
+	switch {
+	case i == j:
+		return complex(real(a.A.At(i, j)), 0)
+	case otherTri(i, j, a.Tri):
+		return cmplx.Conj(a.A.At(j, i))
+	default:
+		return a.A.At(i, j)
+	}
+}
+
+// Set modifies element (i, j) and sets (j, i) to its conjugate.
+// The imaginary part is discarded on the diagonal.
+func (a *Hermitian) Set(i, j int, x complex128) {
+	switch {
+	case i == j:
+		x = complex(real(x), 0)
+	case otherTri(i, j, a.Tri):
+		i, j = j, i
+		x = cmplx.Conj(x)
+	}
+	a.A.Set(i, j, x)
+}
+
+// Returns true if element (i, j) is not in the given triangle.
+func otherTri(i, j int, tri Triangle) bool {
+	switch tri {
+	case UpperTri:
+		return j < i
+	case LowerTri:
+		return i < j
+	default:
+		panic(fmt.Sprintf("unknown triangle: %v", tri))
+	}
+}
@@ -0,0 +1,59 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestCopyHerm(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.H(a))
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		h, err := CopyHerm(a, tri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, a, h)
+	}
+}
+
+func TestHermitian_Set(t *testing.T) {
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		h := NewHermitian(2, tri)
+		h.Set(0, 0, 1+2i)
+		h.Set(1, 0, 3+4i)
+		if got := h.A.At(0, 0); got != 1 {
+			t.Errorf("diagonal: want 1, got %v", got)
+		}
+		if got := h.At(0, 1); got != 3-4i {
+			t.Errorf("transpose: want %v, got %v", 3-4i, got)
+		}
+	}
+}
+
+func TestSolveHerm_hermitian(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.H(a))
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	// Set the upper triangle to garbage, it must not be read.
+	h := NewHermitian(n, LowerTri)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			h.A.Set(i, j, 1e6)
+			if i >= j {
+				h.A.Set(i, j, a.At(i, j))
+			}
+		}
+	}
+	got, err := SolveHerm(h, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
@@ -0,0 +1,42 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestSolveSquareInPlace(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	x, err := SolveSquareInPlace(cloneMat(a), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, x)
+	// Solution is stored in b.
+	if &x[0] != &b[0] {
+		t.Errorf("solution not stored in b")
+	}
+}
+
+func TestSolveFullRankInPlace_capacity(t *testing.T) {
+	m, n := 100, 150
+	a, b, want, err := underDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Capacity is insufficient for solution.
+	if _, err := SolveFullRankInPlace(cloneMat(a), cloneSlice(b)); err == nil {
+		t.Fatal("expected error")
+	}
+	got, err := SolveFullRankInPlace(cloneMat(a), cloneSliceCap(b, n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
@@ -0,0 +1,134 @@
+package clap
+
+// LQ factorization.
+type LQFact struct {
+	A   *Mat
+	Tau []complex128
+}
+
+// Computes LQ factorization.
+// Calls ZGELQF.
+func LQ(a Const) (*LQFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return lq(cloneMat(a))
+}
+
+// a will be modified.
+func lq(a *Mat) (*LQFact, error) {
+	m, n := a.Dims()
+	tau := make([]complex128, min(m, n))
+
+	err := zgelqf(m, n, a.Elems, m, tau)
+	if err != nil {
+		return nil, err
+	}
+	return &LQFact{a, tau}, nil
+}
+
+// L returns a copy of the lower-triangular factor.
+// It has size m x min(m, n).
+func (lq *LQFact) L() *Mat {
+	m, n := lq.A.Dims()
+	k := min(m, n)
+	l := NewMat(m, k)
+	for j := 0; j < k; j++ {
+		for i := j; i < m; i++ {
+			l.Set(i, j, lq.A.At(i, j))
+		}
+	}
+	return l
+}
+
+// Q returns the first min(m, n) rows of the unitary factor.
+// Calls ZUNMLQ.
+func (lq *LQFact) Q() (*Mat, error) {
+	m, n := lq.A.Dims()
+	k := min(m, n)
+	// Q = [I 0] Q
+	q := NewMat(k, n)
+	for i := 0; i < k; i++ {
+		q.Set(i, i, 1)
+	}
+	err := zunmlq(right, false, k, n, k, lq.A.Elems, m, lq.Tau, q.Elems, k)
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Solves a linear system using LQ decomposition.
+// Matrix must be m x n with m <= n (i.e. "fat")
+// for L to be full rank and square.
+//
+// If h is false, finds minimum norm x which satisfies b = A x = L Q x.
+// Computes Q' (L^-1 b).
+//
+// If h is true, finds x which minimizes ||A' x - b|| = ||Q' L' x - b||.
+// Computes L^-H (Q b).
+func (lq *LQFact) Solve(h bool, b []complex128) ([]complex128, error) {
+	if err := errIncompatT(lq.A, h, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	m, n := lq.A.Dims()
+	return finiteResult(lq.solve(h, cloneSliceCap(b, max(m, n))))
+}
+
+// b will be modified.
+// b must have capacity for solution.
+func (lq *LQFact) solve(h bool, b []complex128) ([]complex128, error) {
+	m, n := lq.A.Dims()
+	// In order to be able to solve systems with an LQ factorization,
+	// the matrix must be fat (otherwise use QR, or LQ of transpose).
+	if m > n {
+		return nil, errBadShape(m, n)
+	}
+
+	if !h {
+		// L Q x = b
+		// x = Q' (L \ b)
+		var err error
+
+		// b <- L \ b
+		err = ztrtrs(LowerTri, false, nonUnitDiag, m, 1, lq.A.Elems, m, b, len(b))
+		if err != nil {
+			return nil, err
+		}
+		// Grow b to size of solution if necessary.
+		b = b[:n]
+		for i := m; i < n; i++ {
+			b[i] = 0
+		}
+		// b <- Q' b
+		err = zunmlq(left, true, n, 1, m, lq.A.Elems, m, lq.Tau, b, len(b))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Q' L' x = b
+		// x = L' \ (Q b)
+		var err error
+
+		// b <- Q b
+		err = zunmlq(left, false, n, 1, m, lq.A.Elems, m, lq.Tau, b, len(b))
+		if err != nil {
+			return nil, err
+		}
+		// b <- L' \ b
+		err = ztrtrs(LowerTri, true, nonUnitDiag, m, 1, lq.A.Elems, m, b, len(b))
+		if err != nil {
+			return nil, err
+		}
+		// Shrink b to size of solution if necessary.
+		b = b[:m]
+	}
+
+	return b, nil
+}
@@ -0,0 +1,64 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+// Minimum-norm solution to under-constrained system by LQ decomposition.
+func TestLQFact_Solve_underdetermined(t *testing.T) {
+	m, n := 100, 150
+	a, b, want, err := underDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lq, err := LQ(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := lq.Solve(false, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+// Minimum-residual solution to over-constrained system by LQ decomposition.
+func TestLQFact_Solve_overdetermined(t *testing.T) {
+	m, n := 150, 100
+	a, b, want, err := overDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Take LQ factorization of conjugate transpose.
+	lq, err := LQ(cmat.H(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := lq.Solve(true, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestLQFact_LQ(t *testing.T) {
+	m, n := 100, 150
+	a := randMat(m, n)
+
+	lq, err := LQ(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := lq.Q()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that A = L Q.
+	testMatEq(t, a, cmat.Mul(lq.L(), q))
+	// Check that Q has orthonormal rows.
+	testMatEq(t, cmat.I(m), cmat.Mul(q, cmat.H(q)))
+}
@@ -0,0 +1,67 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+// Returns a copy of a with the upper triangle set to garbage.
+func spoilUpper(a *cmat.Mat) *cmat.Mat {
+	n, _ := a.Dims()
+	b := cmat.New(n, n)
+	cmat.Copy(b, a)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			b.Set(i, j, 1e6)
+		}
+	}
+	return b
+}
+
+func TestEigHermOpts_lower(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.H(a))
+
+	v, d, err := EigHermOpts(spoilUpper(a), &Opts{Tri: LowerTri, NoHermCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc := make([]complex128, n)
+	for i := range d {
+		dc[i] = complex(d[i], 0)
+	}
+	testMatEq(t, a, cmat.Mul(cmat.Mul(v, cmat.NewDiag(dc)), cmat.H(v)))
+}
+
+func TestSolveHermOpts_lower(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = cmat.Plus(a, cmat.H(a))
+	want := randVec(n)
+	b := cmat.MulVec(a, want)
+
+	got, err := SolveHermOpts(spoilUpper(a), b, &Opts{Tri: LowerTri, NoHermCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveHermOpts_zeroTol(t *testing.T) {
+	// Within EpsHermRel of Hermitian but not exactly Hermitian.
+	a := cmat.NewRows([][]complex128{
+		{2, 1 + 1i},
+		{1 - 1i + 1e-12, 2},
+	})
+	b := []complex128{1, 1}
+
+	if _, err := SolveHermOpts(a, b, &Opts{}); err != nil {
+		t.Fatalf("expected default tolerance to accept matrix, got %v", err)
+	}
+	zero := 0.0
+	if _, err := SolveHermOpts(a, b, &Opts{EpsHermAbs: &zero, EpsHermRel: &zero}); err == nil {
+		t.Fatal("expected error for zero tolerance")
+	}
+}
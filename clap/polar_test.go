@@ -0,0 +1,39 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestPolar(t *testing.T) {
+	m, n := 15, 10
+	a := randMat(m, n)
+	u, p, err := Polar(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that U has orthonormal columns and P is Hermitian.
+	testMatEq(t, cmat.I(n), cmat.Mul(cmat.H(u), u))
+	if !cmat.IsHermitian(p, 1e-9) {
+		t.Error("P not Hermitian")
+	}
+	// Check that A = U P.
+	testMatEq(t, a, cmat.Mul(u, p))
+}
+
+func TestPolar_fat(t *testing.T) {
+	m, n := 10, 15
+	a := randMat(m, n)
+	u, p, err := Polar(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that U has orthonormal rows and P is Hermitian.
+	testMatEq(t, cmat.I(m), cmat.Mul(u, cmat.H(u)))
+	if !cmat.IsHermitian(p, 1e-9) {
+		t.Error("P not Hermitian")
+	}
+	// Check that A = P U.
+	testMatEq(t, a, cmat.Mul(p, u))
+}
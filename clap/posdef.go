@@ -3,6 +3,11 @@ package clap
 // Solves A x = b where A is Hermitian and positive-definite.
 // Calls ZPOSV.
 func SolvePosDef(a Const, b []complex128) ([]complex128, error) {
+	return SolvePosDefOpts(a, b, nil)
+}
+
+// SolvePosDefOpts is like SolvePosDef but takes options.
+func SolvePosDefOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,16 +17,25 @@ func SolvePosDef(a Const, b []complex128) ([]complex128, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	if err := errNonHerm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
 		return nil, err
 	}
-	return solvePosDef(cloneMat(a), cloneSlice(b))
+	return opts.finiteResult(solvePosDef(cloneMat(a), cloneSlice(b), opts.tri()))
 }
 
 // a and b will be modified.
-func solvePosDef(a *Mat, b []complex128) ([]complex128, error) {
+func solvePosDef(a *Mat, b []complex128, tri Triangle) ([]complex128, error) {
 	n, _ := a.Dims()
-	err := zposv(n, 1, a.Elems, n, b, n)
+	err := zposv(tri, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
@@ -9,6 +9,12 @@ type QRFact struct {
 // Computes QR factorization.
 // Calls ZGEQRF.
 func QR(a Const) (*QRFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
 	return qr(cloneMat(a))
 }
 
@@ -37,13 +43,17 @@ func (qr *QRFact) Solve(h bool, b []complex128) ([]complex128, error) {
 	if err := errIncompatT(qr.A, h, b); err != nil {
 		return nil, err
 	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
 	m, n := qr.A.Dims()
-	return qr.solve(h, cloneSliceCap(b, max(m, n)))
+	return finiteResult(qr.solve(nil, h, cloneSliceCap(b, max(m, n))))
 }
 
 // b will be modified.
 // b must have capacity for solution.
-func (qr *QRFact) solve(h bool, b []complex128) ([]complex128, error) {
+// The workspace of ZUNMQR is reused if ws is not nil.
+func (qr *QRFact) solve(ws *Workspace, h bool, b []complex128) ([]complex128, error) {
 	m, n := qr.A.Dims()
 	// In order to be able to solve systems with a QR factorization,
 	// the matrix must be skinny (otherwise use LQ, or QR of transpose).
@@ -57,7 +67,7 @@ func (qr *QRFact) solve(h bool, b []complex128) ([]complex128, error) {
 		var err error
 
 		// b <- Q' b
-		err = zunmqr(left, true, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
+		err = zunmqr(ws, left, true, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +91,7 @@ func (qr *QRFact) solve(h bool, b []complex128) ([]complex128, error) {
 			return nil, err
 		}
 		// b <- Q b
-		err = zunmqr(left, false, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
+		err = zunmqr(ws, left, false, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
 		if err != nil {
 			return nil, err
 		}
@@ -3,6 +3,11 @@ package clap
 // Solves A x = b where A is square and full-rank.
 // Calls ZGESV.
 func SolveSquare(a Const, b []complex128) ([]complex128, error) {
+	return SolveSquareOpts(a, b, nil)
+}
+
+// SolveSquareOpts is like SolveSquare but takes options.
+func SolveSquareOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,13 +17,19 @@ func SolveSquare(a Const, b []complex128) ([]complex128, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	return solveSquare(cloneMat(a), cloneSlice(b))
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveSquare(cloneMat(a), cloneSlice(b)))
 }
 
 // a and b will be modified.
 func solveSquare(a *Mat, b []complex128) ([]complex128, error) {
 	n, _ := a.Dims()
-	err := zgesv(n, 1, a.Elems, n, b, n)
+	err := zgesv(nil, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
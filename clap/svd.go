@@ -1,11 +1,15 @@
 package clap
 
 // Computes thin SVD of an m x n matrix.
-// Only the first min(m, n) vectors are computed.
+// Only the first min(m, n) vectors are computed,
+// so U is m x min(m, n) and V' is min(m, n) x n.
 func SVD(a Const) (u *Mat, s []float64, vt *Mat, err error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, nil, nil, err
 	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, nil, err
+	}
 	return svd(cloneMat(a))
 }
 
@@ -14,9 +18,9 @@ func svd(a *Mat) (u *Mat, s []float64, vt *Mat, err error) {
 	k := min(m, n)
 	u = NewMat(m, k)
 	s = make([]float64, k)
-	vt = NewMat(n, k)
+	vt = NewMat(k, n)
 
-	err = zgesdd(m, n, a.Elems, m, s, u.Elems, m, vt.Elems, n)
+	err = zgesdd(nil, m, n, a.Elems, m, s, u.Elems, m, vt.Elems, k)
 	if err != nil {
 		return nil, nil, nil, err
 	}
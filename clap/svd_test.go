@@ -0,0 +1,23 @@
+package clap
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/cmat"
+)
+
+func TestSVD(t *testing.T) {
+	for _, size := range [][2]int{{15, 10}, {10, 15}} {
+		m, n := size[0], size[1]
+		want := randMat(m, n)
+
+		u, s, vt, err := SVD(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Check that A = U S V'.
+		got := cmat.Mul(u, cmat.Mul(realDiag(s), vt))
+		testMatEq(t, want, got)
+	}
+}
@@ -35,6 +35,15 @@ func randVec(n int) []complex128 {
 	return x
 }
 
+// Returns a diagonal matrix with real elements.
+func realDiag(d []float64) *cmat.Mat {
+	z := make([]complex128, len(d))
+	for i := range d {
+		z[i] = complex(d[i], 0)
+	}
+	return cmat.NewDiag(z)
+}
+
 func testDimsEq(t *testing.T, want, got Const) {
 	if !eqDims(want, got) {
 		m, n := want.Dims()
@@ -0,0 +1,329 @@
+package clap
+
+// SolveFullRank is like the function SolveFullRank
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveFullRank(a Const, b []complex128) ([]complex128, error) {
+	return ws.SolveFullRankOpts(a, b, nil)
+}
+
+// SolveFullRankOpts is like SolveFullRank but takes options.
+func (ws *Workspace) SolveFullRankOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, max(m, n))[:max(m, n)]
+	err := zgels(ws, m, n, 1, x.Elems, m, y, len(y))
+	return opts.finiteResult(y[:n], err)
+}
+
+// Solve is like the function Solve
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) Solve(a Const, b []complex128) ([]complex128, error) {
+	return ws.SolveEps(a, b, DefaultEps)
+}
+
+// SolveOpts is like the function SolveOpts
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	return ws.solveEpsOpts(a, b, opts.eps(), opts)
+}
+
+// SolveEps is like the function SolveEps
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveEps(a Const, b []complex128, eps float64) ([]complex128, error) {
+	return ws.solveEpsOpts(a, b, eps, nil)
+}
+
+func (ws *Workspace) solveEpsOpts(a Const, b []complex128, eps float64, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, max(m, n))[:max(m, n)]
+	_, err := zgelsd(ws, m, n, 1, x.Elems, m, y, len(y), eps)
+	return opts.finiteResult(y[:n], err)
+}
+
+// Eig is like the function Eig
+// but reuses the memory of the workspace.
+// The factors are overwritten by the next call.
+func (ws *Workspace) Eig(a Const) (*Mat, []complex128, error) {
+	return ws.EigOpts(a, nil)
+}
+
+// EigOpts is like Eig but takes options.
+func (ws *Workspace) EigOpts(a Const, opts *Opts) (*Mat, []complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errBadBalance(opts.balance()); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	v := ws.mat("eig.v", n, n)
+	if job := opts.balance(); job != BalanceBoth {
+		// ZGEEV always balances both ways.
+		r, err := zgeevx(job, false, n, x.Elems, n, nil, 1, v.Elems, n)
+		if err != nil {
+			return nil, nil, err
+		}
+		return v, r.w, nil
+	}
+	d, err := zgeev(ws, values, vectors, n, x.Elems, n, nil, 1, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, d, nil
+}
+
+// SolveHerm is like the function SolveHerm
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveHerm(a Const, b []complex128) ([]complex128, error) {
+	return ws.SolveHermOpts(a, b, nil)
+}
+
+// SolveHermOpts is like SolveHerm but takes options.
+func (ws *Workspace) SolveHermOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := zhesv(ws, opts.tri(), n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// SVD is like the function SVD
+// but reuses the memory of the workspace.
+// The factors are overwritten by the next call.
+func (ws *Workspace) SVD(a Const) (u *Mat, s []float64, vt *Mat, err error) {
+	return ws.SVDOpts(a, nil)
+}
+
+// SVDOpts is like SVD but takes options.
+func (ws *Workspace) SVDOpts(a Const, opts *Opts) (u *Mat, s []float64, vt *Mat, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, nil, err
+	}
+	m, n := a.Dims()
+	k := min(m, n)
+	x := ws.cloneMat("a", a)
+	u = ws.mat("svd.u", m, k)
+	s = ws.floats("svd.s", k)
+	vt = ws.mat("svd.vt", k, n)
+
+	err = zgesdd(ws, m, n, x.Elems, m, s, u.Elems, m, vt.Elems, k)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return u, s, vt, nil
+}
+
+// EigHerm is like the function EigHerm
+// but reuses the memory of the workspace.
+// The factors are overwritten by the next call.
+func (ws *Workspace) EigHerm(a Const) (*Mat, []float64, error) {
+	return ws.EigHermOpts(a, nil)
+}
+
+// EigHermOpts is like EigHerm but takes options.
+func (ws *Workspace) EigHermOpts(a Const, opts *Opts) (*Mat, []float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, nil, err
+	}
+	n, _ := a.Dims()
+	v := ws.cloneMat("eig.v", a)
+	d, err := zheev(ws, vectors, opts.tri(), n, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, d, nil
+}
+
+// SolveSquare is like the function SolveSquare
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveSquare(a Const, b []complex128) ([]complex128, error) {
+	return ws.SolveSquareOpts(a, b, nil)
+}
+
+// SolveSquareOpts is like SolveSquare but takes options.
+func (ws *Workspace) SolveSquareOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := zgesv(ws, n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// SolvePosDef is like the function SolvePosDef
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolvePosDef(a Const, b []complex128) ([]complex128, error) {
+	return ws.SolvePosDefOpts(a, b, nil)
+}
+
+// SolvePosDefOpts is like SolvePosDef but takes options.
+func (ws *Workspace) SolvePosDefOpts(a Const, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := zposv(opts.tri(), n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// LDL is like the function LDL
+// but reuses the memory of the workspace.
+// The factorization is overwritten by the next call.
+func (ws *Workspace) LDL(a Const) (*LDLFact, error) {
+	return ws.LDLOpts(a, nil)
+}
+
+// LDLOpts is like LDL but takes options.
+func (ws *Workspace) LDLOpts(a Const, opts *Opts) (*LDLFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonHerm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	piv, err := zhetrf(ws, opts.tri(), n, x.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	ws.ldl = LDLFact{x, opts.tri(), piv}
+	return &ws.ldl, nil
+}
+
+// QRSolve is like the method Solve of QRFact
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) QRSolve(qr *QRFact, h bool, b []complex128) ([]complex128, error) {
+	return ws.QRSolveOpts(qr, h, b, nil)
+}
+
+// QRSolveOpts is like QRSolve but takes options.
+func (ws *Workspace) QRSolveOpts(qr *QRFact, h bool, b []complex128, opts *Opts) ([]complex128, error) {
+	if err := errIncompatT(qr.A, h, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := qr.A.Dims()
+	return opts.finiteResult(qr.solve(ws, h, ws.cloneSliceCap("b", b, max(m, n))))
+}
@@ -3,7 +3,8 @@
 dir=../mat
 gofiles=.gofiles
 
-ls $dir | grep '\.go$' >$gofiles
+# Norms and predicates are written separately for complex matrices.
+ls $dir | grep '\.go$' | grep -v -e '^norm' -e '^pred' >$gofiles
 for f in `cat $gofiles`
 do
 	echo $dir/$f -\> ./$f
@@ -0,0 +1,185 @@
+package cmat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// NormKind identifies a matrix norm.
+type NormKind int
+
+const (
+	// Maximum absolute column sum.
+	OneNorm NormKind = iota + 1
+	// Maximum absolute row sum.
+	InfNorm
+	// Square root of the sum of squared moduli.
+	FrobNorm
+	// Maximum absolute element (not sub-multiplicative).
+	MaxNorm
+	// Largest singular value.
+	SpectralNorm
+	// Sum of singular values.
+	NuclearNorm
+)
+
+func (kind NormKind) String() string {
+	switch kind {
+	case OneNorm:
+		return "one"
+	case InfNorm:
+		return "inf"
+	case FrobNorm:
+		return "frob"
+	case MaxNorm:
+		return "max"
+	case SpectralNorm:
+		return "spectral"
+	case NuclearNorm:
+		return "nuclear"
+	default:
+		return fmt.Sprintf("NormKind(%d)", int(kind))
+	}
+}
+
+// Computes a norm of a matrix.
+// The spectral and nuclear norms are computed from the singular values
+// by complex one-sided Jacobi rotations as in mat.Norm,
+// which is only practical for small matrices;
+// use the clap package to compute the singular values of large matrices.
+//
+// Panics if the kind of norm is not known.
+func Norm(a Const, kind NormKind) float64 {
+	switch kind {
+	case OneNorm:
+		return normOne(a)
+	case InfNorm:
+		return normOne(T(a))
+	case FrobNorm:
+		return normFrob(a)
+	case MaxNorm:
+		return normMax(a)
+	case SpectralNorm:
+		var r float64
+		for _, s := range singVals(a) {
+			r = math.Max(r, s)
+		}
+		return r
+	case NuclearNorm:
+		var r float64
+		for _, s := range singVals(a) {
+			r += s
+		}
+		return r
+	default:
+		panic(fmt.Sprintf("unknown norm: %v", kind))
+	}
+}
+
+func normOne(a Const) float64 {
+	m, n := a.Dims()
+	var r float64
+	for j := 0; j < n; j++ {
+		var s float64
+		for i := 0; i < m; i++ {
+			s += cmplx.Abs(a.At(i, j))
+		}
+		r = math.Max(r, s)
+	}
+	return r
+}
+
+func normFrob(a Const) float64 {
+	m, n := a.Dims()
+	var r float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			r = math.Hypot(r, cmplx.Abs(a.At(i, j)))
+		}
+	}
+	return r
+}
+
+func normMax(a Const) float64 {
+	m, n := a.Dims()
+	var r float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			r = math.Max(r, cmplx.Abs(a.At(i, j)))
+		}
+	}
+	return r
+}
+
+// Maximum number of sweeps of Jacobi rotations.
+// Convergence is quadratic and usually takes fewer than ten sweeps.
+const maxSweeps = 30
+
+// Computes the min(m, n) singular values of a matrix in no particular order.
+// Orthogonalizes the columns by one-sided Jacobi rotations
+// until every pair of columns p, q satisfies |<b_p, b_q>| <= tol ||b_p|| ||b_q||
+// with tol = m times the machine epsilon.
+// Each rotation first multiplies column q by a unit phase
+// so that the inner product is real, which does not change the singular values.
+//
+// Panics if the columns are not orthogonal after maxSweeps sweeps.
+func singVals(a Const) []float64 {
+	m, n := a.Dims()
+	if m < n {
+		a = H(a)
+		m, n = n, m
+	}
+	b := New(m, n)
+	Copy(b, a)
+
+	tol := float64(m) * epsilon
+	for sweep := 0; ; sweep++ {
+		if sweep == maxSweeps {
+			panic(fmt.Sprintf("singular values did not converge in %d sweeps", maxSweeps))
+		}
+		var rotated bool
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta float64
+				var gamma complex128
+				for i := 0; i < m; i++ {
+					u, v := b.At(i, p), b.At(i, q)
+					alpha += real(u)*real(u) + imag(u)*imag(u)
+					beta += real(v)*real(v) + imag(v)*imag(v)
+					gamma += cmplx.Conj(u) * v
+				}
+				g := cmplx.Abs(gamma)
+				if !(g > tol*math.Sqrt(alpha)*math.Sqrt(beta)) {
+					continue
+				}
+				rotated = true
+				// Conjugate of the phase of gamma.
+				phase := cmplx.Conj(gamma) / complex(g, 0)
+				zeta := (beta - alpha) / (2 * g)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Hypot(1, zeta))
+				c := 1 / math.Hypot(1, t)
+				s := c * t
+				for i := 0; i < m; i++ {
+					u, v := b.At(i, p), phase*b.At(i, q)
+					b.Set(i, p, complex(c, 0)*u-complex(s, 0)*v)
+					b.Set(i, q, complex(s, 0)*u+complex(c, 0)*v)
+				}
+			}
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	sigma := make([]float64, n)
+	for j := range sigma {
+		for i := 0; i < m; i++ {
+			sigma[j] = math.Hypot(sigma[j], cmplx.Abs(b.At(i, j)))
+		}
+	}
+	return sigma
+}
+
+// Machine epsilon (the spacing of floats at one).
+const epsilon = 0x1p-52
@@ -0,0 +1,68 @@
+package cmat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestNorm(t *testing.T) {
+	// A = diag(3, 1) U with U unitary.
+	r := complex(1/math.Sqrt2, 0)
+	a := NewRows([][]complex128{
+		{3 * r, 3i * r},
+		{1i * r, r},
+	})
+	cases := []struct {
+		Kind NormKind
+		Want float64
+	}{
+		{OneNorm, 4 / math.Sqrt2},
+		{InfNorm, 6 / math.Sqrt2},
+		{FrobNorm, math.Sqrt(10)},
+		{MaxNorm, 3 / math.Sqrt2},
+		{SpectralNorm, 3},
+		{NuclearNorm, 4},
+	}
+	for _, c := range cases {
+		if got := Norm(a, c.Kind); math.Abs(c.Want-got) > eps {
+			t.Errorf("%v: want %.6g, got %.6g", c.Kind, c.Want, got)
+		}
+	}
+}
+
+func TestNorm_converge(t *testing.T) {
+	// A = P diag(s) Q with P, Q complex Householder reflectors,
+	// whose columns are far from orthogonal.
+	const m, n = 10, 8
+	s := New(m, n)
+	var nuc float64
+	for i := 0; i < n; i++ {
+		s.Set(i, i, complex(float64(i+1), 0))
+		nuc += float64(i + 1)
+	}
+	a := Mul(Mul(reflector(m), s), reflector(n))
+	if got := Norm(a, SpectralNorm); math.Abs(n-got) > 1e-10 {
+		t.Errorf("spectral: want %d, got %.6g", n, got)
+	}
+	if got := Norm(a, NuclearNorm); math.Abs(nuc-got) > 1e-10 {
+		t.Errorf("nuclear: want %.6g, got %.6g", nuc, got)
+	}
+}
+
+// Returns the n x n reflector I - 2 v v' / (v' v) with v_k = k + i (n - k).
+func reflector(n int) *Mat {
+	v := make([]complex128, n)
+	var vv float64
+	for k := range v {
+		v[k] = complex(float64(k+1), float64(n-k))
+		vv += real(v[k])*real(v[k]) + imag(v[k])*imag(v[k])
+	}
+	h := I(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.Set(i, j, h.At(i, j)-complex(2/vv, 0)*v[i]*cmplx.Conj(v[j]))
+		}
+	}
+	return h
+}
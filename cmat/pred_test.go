@@ -0,0 +1,54 @@
+package cmat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsHermitian(t *testing.T) {
+	a := NewRows([][]complex128{
+		{1, 2 + 1i},
+		{2 - 1i, 3},
+	})
+	if !IsHermitian(a, eps) {
+		t.Error("want Hermitian")
+	}
+	if IsSymmetric(a, eps) {
+		t.Error("want not symmetric")
+	}
+	a.Set(0, 0, 1+1i)
+	if IsHermitian(a, eps) {
+		t.Error("want not Hermitian with complex diagonal")
+	}
+}
+
+func TestIsUnitary(t *testing.T) {
+	r := complex(1/math.Sqrt2, 0)
+	u := NewRows([][]complex128{
+		{r, 1i * r},
+		{1i * r, r},
+	})
+	if !IsUnitary(u, eps) {
+		t.Error("want unitary")
+	}
+	if !IsUnitary(H(u), eps) {
+		t.Error("want conjugate transpose unitary")
+	}
+	u.Set(0, 1, r)
+	if IsUnitary(u, eps) {
+		t.Error("want not unitary")
+	}
+}
+
+func TestIsBanded(t *testing.T) {
+	a := NewRows([][]complex128{
+		{1, 2i, 0},
+		{0, 4, 5i},
+	})
+	if !IsUpperTriangular(a, 0) || !IsBanded(a, 0, 1, 0) {
+		t.Error("want upper bidiagonal")
+	}
+	if IsLowerTriangular(a, 0) || IsDiagonal(a, 0) {
+		t.Error("want not lower triangular or diagonal")
+	}
+}
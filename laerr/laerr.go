@@ -0,0 +1,130 @@
+// Package laerr defines the errors returned by packages lapack and clap.
+//
+// Failures reported by LAPACK routines are returned as *Error,
+// whose Kind is one of the sentinel values below.
+// Use errors.Is to test the kind of failure
+// and errors.As to obtain the routine name, info code and index:
+//
+//	if errors.Is(err, laerr.ErrSingular) { ... }
+//
+//	var e *laerr.Error
+//	if errors.As(err, &e) {
+//		fmt.Println(e.Routine, e.Info, e.Index)
+//	}
+package laerr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of failure.
+var (
+	// An argument had an illegal value.
+	// Indicates a bug in the calling package rather than bad input.
+	ErrInvalidArg = errors.New("invalid argument")
+	// Matrix is exactly singular.
+	ErrSingular = errors.New("exactly singular")
+	// Matrix is not positive-definite.
+	ErrNotPosDef = errors.New("not positive definite")
+	// Matrix does not have full rank.
+	ErrNotFullRank = errors.New("not full rank")
+	// Iterative algorithm failed to converge.
+	ErrFailConverge = errors.New("failed to converge")
+	// Matrix is singular to working precision.
+	ErrNearSingular = errors.New("singular to working precision")
+	// LAPACK returned an unexpected info code.
+	ErrUnknown = errors.New("unknown info code")
+	// Matrix or vector has an element which is NaN or Inf.
+	ErrNonFinite = errors.New("not finite")
+)
+
+// Error describes a failure reported by a LAPACK routine.
+type Error struct {
+	// Name of the routine which failed, e.g. "DGESV".
+	Routine string
+	// Info code returned by the routine.
+	Info int
+	// Zero-based index of the offending argument, row, column or diagonal element,
+	// or -1 if the failure does not refer to an index.
+	Index int
+	// Name of the offending matrix, if known.
+	Arg string
+	// One of the sentinel errors in this package.
+	Kind error
+}
+
+// New returns an error of the given kind.
+func New(routine string, info, index int, kind error) *Error {
+	return &Error{Routine: routine, Info: info, Index: index, Kind: kind}
+}
+
+func (err *Error) Error() string {
+	switch {
+	case err.Kind == ErrInvalidArg:
+		return fmt.Sprintf("%s: %v: argument %d", err.Routine, err.Kind, err.Index+1)
+	case err.Arg != "":
+		return fmt.Sprintf("%s: %s %v", err.Routine, err.Arg, err.Kind)
+	case err.Index >= 0:
+		return fmt.Sprintf("%s: %v: at index %d", err.Routine, err.Kind, err.Index)
+	default:
+		return fmt.Sprintf("%s: %v: info %d", err.Routine, err.Kind, err.Info)
+	}
+}
+
+// Unwrap returns the kind of error.
+func (err *Error) Unwrap() error {
+	return err.Kind
+}
+
+// NearSingularError is returned alongside the solution by the expert drivers
+// when the matrix is singular to working precision.
+// The solution may still be useful but should be treated with caution.
+//
+// errors.Is(err, ErrNearSingular) reports whether err is a NearSingularError.
+type NearSingularError struct {
+	// Name of the routine, e.g. "DGESVX".
+	Routine string
+	// Reciprocal condition number.
+	RCond float64
+}
+
+func (err *NearSingularError) Error() string {
+	return fmt.Sprintf("%s: %v: rcond %g", err.Routine, ErrNearSingular, err.RCond)
+}
+
+// Unwrap returns ErrNearSingular.
+func (err *NearSingularError) Unwrap() error {
+	return ErrNearSingular
+}
+
+// NonFiniteError is returned when an argument, or a result computed from finite arguments,
+// has an element which is NaN or Inf.
+//
+// errors.Is(err, ErrNonFinite) reports whether err is a NonFiniteError.
+type NonFiniteError struct {
+	// "matrix" or "vector" for an argument, "result" for a result.
+	Arg string
+	// Zero-based row and column of the element.
+	// Col is -1 for a vector.
+	Row, Col int
+	// Value of the element.
+	// The imaginary part is zero for a real matrix or vector.
+	Value complex128
+}
+
+func (err *NonFiniteError) Error() string {
+	var v interface{} = err.Value
+	if imag(err.Value) == 0 {
+		v = real(err.Value)
+	}
+	if err.Col < 0 {
+		return fmt.Sprintf("%s %v: at %d: %v", err.Arg, ErrNonFinite, err.Row, v)
+	}
+	return fmt.Sprintf("%s %v: at %d, %d: %v", err.Arg, ErrNonFinite, err.Row, err.Col, v)
+}
+
+// Unwrap returns ErrNonFinite.
+func (err *NonFiniteError) Unwrap() error {
+	return ErrNonFinite
+}
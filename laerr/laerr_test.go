@@ -0,0 +1,72 @@
+package laerr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	var err error = New("DGESV", 3, 2, ErrSingular)
+	// Wrapping must not hide the kind.
+	err = fmt.Errorf("solve: %w", err)
+	if !errors.Is(err, ErrSingular) {
+		t.Errorf("expected ErrSingular")
+	}
+	if errors.Is(err, ErrNotPosDef) {
+		t.Errorf("did not expect ErrNotPosDef")
+	}
+}
+
+func TestError_As(t *testing.T) {
+	var err error = New("DPOTRF", 5, 4, ErrNotPosDef)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *Error")
+	}
+	if e.Routine != "DPOTRF" || e.Info != 5 || e.Index != 4 {
+		t.Errorf("want DPOTRF, info 5, index 4: got %s, info %d, index %d", e.Routine, e.Info, e.Index)
+	}
+}
+
+func TestNearSingularError_Is(t *testing.T) {
+	var err error = &NearSingularError{Routine: "DGESVX", RCond: 1e-20}
+	if !errors.Is(err, ErrNearSingular) {
+		t.Errorf("expected ErrNearSingular")
+	}
+	var e *NearSingularError
+	if !errors.As(err, &e) || e.RCond != 1e-20 {
+		t.Errorf("expected *NearSingularError with rcond 1e-20")
+	}
+}
+
+func TestNonFiniteError_Is(t *testing.T) {
+	var err error = &NonFiniteError{Arg: "matrix", Row: 2, Col: 1, Value: complex(math.NaN(), 0)}
+	if !errors.Is(err, ErrNonFinite) {
+		t.Errorf("expected ErrNonFinite")
+	}
+	var e *NonFiniteError
+	if !errors.As(err, &e) || e.Row != 2 || e.Col != 1 {
+		t.Errorf("expected *NonFiniteError at 2, 1")
+	}
+}
+
+func ExampleNonFiniteError() {
+	fmt.Println(&NonFiniteError{Arg: "matrix", Row: 2, Col: 1, Value: complex(math.NaN(), 0)})
+	fmt.Println(&NonFiniteError{Arg: "result", Row: 3, Col: -1, Value: complex(math.Inf(1), 0)})
+	fmt.Println(&NonFiniteError{Arg: "vector", Row: 0, Col: -1, Value: complex(1, math.Inf(-1))})
+	// Output:
+	// matrix not finite: at 2, 1: NaN
+	// result not finite: at 3: +Inf
+	// vector not finite: at 0: (1-Infi)
+}
+
+func ExampleError() {
+	err := New("DGETRF", 2, 1, ErrSingular)
+	fmt.Println(err)
+	fmt.Println(New("DGELS", -4, 3, ErrInvalidArg))
+	// Output:
+	// DGETRF: exactly singular: at index 1
+	// DGELS: invalid argument: argument 4
+}
@@ -0,0 +1,197 @@
+package lapack
+
+import (
+	"errors"
+
+	"github.com/jvlmdr/lin-go/laerr"
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// SolvePath identifies the LAPACK driver used by SolveAuto.
+type SolvePath string
+
+const (
+	PathTri     = SolvePath("DTRTRS")
+	PathTridiag = SolvePath("DGTSV")
+	PathPosDef  = SolvePath("DPOSV")
+	PathSymm    = SolvePath("DSYSV")
+	PathSquare  = SolvePath("DGESV")
+	PathEps     = SolvePath("DGELSD")
+)
+
+// SolveAuto solves A x = b using the fastest driver suited to the structure of A.
+// Square matrices which are
+// triangular (DTRTRS), tridiagonal (DGTSV),
+// exactly symmetric with a positive diagonal (DPOSV),
+// exactly symmetric (DSYSV) or general (DGESV)
+// are solved exactly.
+// Symmetric matrices with a positive diagonal which are not positive-definite
+// are solved again by DSYSV.
+//
+// Except for tridiagonal matrices,
+// the reciprocal condition number of A is estimated from the factorization
+// (DTRCON, DPOCON, DSYCON or DGECON).
+// If A is not square, is exactly singular
+// or has a reciprocal condition number less than DefaultEps,
+// the system is solved in the least-squares sense by SolveEps with DefaultEps (DGELSD).
+//
+// Returns the driver which computed the solution.
+// Inspecting A takes O(n^2) time.
+func SolveAuto(a Const, b []float64) (x []float64, path SolvePath, err error) {
+	return SolveAutoOpts(a, b, nil)
+}
+
+// SolveAutoOpts is like SolveAuto but takes options.
+//
+// Unlike elsewhere, the symmetry check chooses a driver rather than returning an error.
+// Nil tolerances EpsSymmAbs and EpsSymmRel select exact symmetry
+// because the symmetric drivers read only the triangle Tri.
+// NoSymmCheck treats every matrix which is not triangular or tridiagonal as symmetric.
+// Eps is both the threshold on the reciprocal condition number
+// and the tolerance passed to SolveEps.
+func SolveAutoOpts(a Const, b []float64, opts *Opts) (x []float64, path SolvePath, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, "", err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, "", err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, "", err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, "", err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, "", err
+	}
+	m, n := a.Dims()
+	if m == n {
+		x, path, err = solveSquareAuto(a, b, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		if path != PathEps {
+			return x, path, nil
+		}
+	}
+	x, err = solveEpsOpts(a, b, opts.eps(), opts)
+	if err != nil {
+		return nil, "", err
+	}
+	return x, PathEps, nil
+}
+
+// Assumes that a is square and b has compatible length.
+// Returns PathEps without a solution if a is exactly singular
+// or its reciprocal condition number is less than opts.eps().
+func solveSquareAuto(a Const, b []float64, opts *Opts) ([]float64, SolvePath, error) {
+	n, _ := a.Dims()
+	switch {
+	case mat.IsUpperTriangular(a, 0):
+		x, rcond, err := solveTriCond(UpperTri, cloneMat(a), cloneSlice(b))
+		return autoResult(x, rcond, err, PathTri, opts)
+	case mat.IsLowerTriangular(a, 0):
+		x, rcond, err := solveTriCond(LowerTri, cloneMat(a), cloneSlice(b))
+		return autoResult(x, rcond, err, PathTri, opts)
+	case mat.IsBanded(a, 1, 1, 0):
+		t := NewTridiag(n)
+		for i := 0; i < n; i++ {
+			t.Diag[i] = a.At(i, i)
+			if i+1 < n {
+				t.Sub[i] = a.At(i+1, i)
+				t.Super[i] = a.At(i, i+1)
+			}
+		}
+		x, err := solveTridiag(t, cloneSlice(b))
+		// The condition number is not estimated.
+		return autoResult(x, 1, err, PathTridiag, opts)
+	}
+
+	// Only exact symmetry is safe by default because one triangle is ignored.
+	symm := opts.symmAuto()
+	if symm.errNonSymm(a) != nil {
+		x, rcond, err := solveSquareCond(cloneMat(a), cloneSlice(b), mat.Norm(a, mat.OneNorm))
+		return autoResult(x, rcond, err, PathSquare, opts)
+	}
+	tri := opts.tri()
+	anorm := mat.Norm(&Symmetric{cloneMat(a), tri}, mat.OneNorm)
+	if posDiag(a) {
+		x, rcond, err := solvePosDefCond(cloneMat(a), cloneSlice(b), tri, anorm)
+		if !errors.Is(err, laerr.ErrNotPosDef) {
+			return autoResult(x, rcond, err, PathPosDef, opts)
+		}
+	}
+	x, rcond, err := solveSymmCond(cloneMat(a), cloneSlice(b), tri, anorm)
+	return autoResult(x, rcond, err, PathSymm, opts)
+}
+
+// Maps the result of a driver to the result of solveSquareAuto.
+func autoResult(x []float64, rcond float64, err error, path SolvePath, opts *Opts) ([]float64, SolvePath, error) {
+	if errors.Is(err, laerr.ErrSingular) || err == nil && rcond < opts.eps() {
+		return nil, PathEps, nil
+	}
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, "", err
+	}
+	return x, path, nil
+}
+
+// a and b will be modified.
+func solveTriCond(tri Triangle, a *Mat, b []float64) ([]float64, float64, error) {
+	n, _ := a.Dims()
+	rcond, err := dtrcon(tri, nonUnitDiag, n, a.Elems, n)
+	if err != nil {
+		return nil, 0, err
+	}
+	x, err := triSolve(tri, false, nonUnitDiag, a, b)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, rcond, nil
+}
+
+// a and b will be modified.
+func solveSymmCond(a *Mat, b []float64, tri Triangle, anorm float64) ([]float64, float64, error) {
+	n, _ := a.Dims()
+	fact, err := ldl(a, tri)
+	if err != nil {
+		return nil, 0, err
+	}
+	rcond, err := dsycon(tri, n, a.Elems, n, fact.Piv, anorm)
+	if err != nil {
+		return nil, 0, err
+	}
+	x, err := fact.solve(b)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, rcond, nil
+}
+
+// Returns options for the symmetry check in SolveAutoOpts.
+func (opts *Opts) symmAuto() *Opts {
+	symm := &Opts{EpsSymmAbs: new(float64), EpsSymmRel: new(float64)}
+	if opts == nil {
+		return symm
+	}
+	symm.NoSymmCheck = opts.NoSymmCheck
+	if opts.EpsSymmAbs != nil {
+		symm.EpsSymmAbs = opts.EpsSymmAbs
+	}
+	if opts.EpsSymmRel != nil {
+		symm.EpsSymmRel = opts.EpsSymmRel
+	}
+	return symm
+}
+
+// Returns true if every diagonal element is positive.
+func posDiag(a Const) bool {
+	n, _ := a.Dims()
+	for i := 0; i < n; i++ {
+		if !(a.At(i, i) > 0) {
+			return false
+		}
+	}
+	return true
+}
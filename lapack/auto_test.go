@@ -0,0 +1,145 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveAuto(t *testing.T) {
+	n := 50
+	gen := randMat(n, n)
+	lower := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			lower.Set(i, j, gen.At(i, j))
+		}
+		lower.Set(i, i, float64(n))
+	}
+	tridiag := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := i - 1; j <= i+1; j++ {
+			if j >= 0 && j < n {
+				tridiag.Set(i, j, gen.At(i, j))
+			}
+		}
+		tridiag.Set(i, i, 4)
+	}
+	tall := randMat(2*n, n)
+	posdef := mat.Mul(mat.T(tall), tall)
+	symm := mat.Plus(gen, mat.T(gen))
+	// Make the diagonal positive although it is indefinite.
+	for i := 0; i < n; i++ {
+		symm.Set(i, i, 1)
+	}
+
+	cases := []struct {
+		Name string
+		A    *mat.Mat
+		Path SolvePath
+	}{
+		{"lower", lower, PathTri},
+		{"upper", mat.T(lower), PathTri},
+		{"tridiag", tridiag, PathTridiag},
+		{"posdef", posdef, PathPosDef},
+		{"symm", symm, PathSymm},
+		{"square", gen, PathSquare},
+	}
+	for _, c := range cases {
+		want := randVec(n)
+		b := mat.MulVec(c.A, want)
+		got, path, err := SolveAuto(c.A, b)
+		if err != nil {
+			t.Errorf("%s: %v", c.Name, err)
+			continue
+		}
+		if path != c.Path {
+			t.Errorf("%s: want path %s, got %s", c.Name, c.Path, path)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestSolveAuto_nearSymm(t *testing.T) {
+	// Within EpsSymmRel of symmetric but not exactly symmetric.
+	a := mat.NewRows([][]float64{
+		{2, 1},
+		{1 + 1e-12, 2},
+	})
+	want := []float64{1, -1}
+	got, path, err := SolveAuto(a, mat.MulVec(a, want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != PathSquare {
+		t.Errorf("want path %s, got %s", PathSquare, path)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveAuto_singular(t *testing.T) {
+	// Rank-deficient square matrix falls back to least squares.
+	a := mat.NewRows([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	_, path, err := SolveAuto(a, []float64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != PathEps {
+		t.Errorf("want path %s, got %s", PathEps, path)
+	}
+}
+
+func TestSolveAuto_skinny(t *testing.T) {
+	a, b, want, err := overDetProb(100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, path, err := SolveAuto(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != PathEps {
+		t.Errorf("want path %s, got %s", PathEps, path)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveAuto_illCond(t *testing.T) {
+	// The Hilbert matrix is positive-definite with condition number about 1e16.
+	n := 12
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	_, path, err := SolveAuto(a, mat.MulVec(a, randVec(n)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != PathEps {
+		t.Errorf("want path %s, got %s", PathEps, path)
+	}
+}
+
+func TestSolveAutoOpts_nearSymm(t *testing.T) {
+	// Symmetric within the tolerance and positive-definite.
+	a := mat.NewRows([][]float64{
+		{2, 1},
+		{1 + 1e-12, 2},
+	})
+	want := []float64{1, -1}
+	rel := 1e-9
+	opts := &Opts{Tri: UpperTri, EpsSymmRel: &rel}
+	got, path, err := SolveAutoOpts(a, mat.MulVec(a, want), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != PathPosDef {
+		t.Errorf("want path %s, got %s", PathPosDef, path)
+	}
+	testSliceEq(t, want, got)
+}
@@ -54,3 +54,12 @@ func jobzChar(jobz jobzMode) C.char {
 		panic(fmt.Sprintf("invalid jobz value: %v", rune(jobz)))
 	}
 }
+
+func balanceChar(job BalanceJob) C.char {
+	switch job {
+	case NoBalance, BalancePermute, BalanceScale, BalanceBoth:
+		return C.char(job)
+	default:
+		panic(fmt.Sprintf("invalid balance value: %v", rune(job)))
+	}
+}
@@ -0,0 +1,37 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGEBAK: (Double-precision) GEneral BAlance bacK-transform
+//
+// http://www.netlib.org/lapack/double/dgebak.f
+//
+// ilo and ihi are one-based.
+func dgebak(job BalanceJob, side matSide, n, ilo, ihi int, scale []float64, m int, v []float64, ldv int) error {
+	var (
+		job_   = balanceChar(job)
+		side_  = sideChar(side)
+		n_     = C.integer(n)
+		ilo_   = C.integer(ilo)
+		ihi_   = C.integer(ihi)
+		scale_ = ptrFloat64(scale)
+		m_     = C.integer(m)
+		v_     = ptrFloat64(v)
+		ldv_   = C.integer(ldv)
+	)
+	var info_ C.integer
+
+	C.dgebak_(&job_, &side_, &n_, &ilo_, &ihi_, scale_, &m_, v_, &ldv_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGEBAK", -info)
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("DGEBAK", info))
+	}
+}
@@ -0,0 +1,33 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGEBAL: (Double-precision) GEneral BALance
+//
+// http://www.netlib.org/lapack/double/dgebal.f
+//
+// ilo and ihi are one-based.
+func dgebal(job BalanceJob, n int, a []float64, lda int, scale []float64) (ilo, ihi int, err error) {
+	var (
+		job_   = balanceChar(job)
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		scale_ = ptrFloat64(scale)
+	)
+	var ilo_, ihi_, info_ C.integer
+
+	C.dgebal_(&job_, &n_, a_, &lda_, &ilo_, &ihi_, scale_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, 0, errInvalidArg("DGEBAL", -info)
+	case info == 0:
+		return int(ilo_), int(ihi_), nil
+	default:
+		panic(errUnknown("DGEBAL", info))
+	}
+}
@@ -0,0 +1,39 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGECON: (Double-precision) GEneral CONdition number
+//
+// http://www.netlib.org/lapack/double/dgecon.f
+//
+// Estimates the reciprocal condition number in the one-norm
+// given the LU factorization and the one-norm of the original matrix.
+func dgecon(n int, a []float64, lda int, anorm float64) (rcond float64, err error) {
+	var (
+		norm_  = C.char('1')
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		anorm_ = C.doublereal(anorm)
+		work_  = ptrFloat64(make([]float64, 4*n))
+		iwork_ = ptrInt(make([]C.integer, n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.dgecon_(&norm_, &n_, a_, &lda_, &anorm_, &rcond_, work_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("DGECON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("DGECON", info))
+	}
+}
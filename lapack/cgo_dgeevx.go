@@ -0,0 +1,88 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// Result of DGEEVX other than the eigenvectors.
+// ilo and ihi are one-based.
+type geevxResult struct {
+	wr, wi         []float64
+	ilo, ihi       int
+	scale          []float64
+	abnrm          float64
+	rconde, rcondv []float64
+}
+
+// DGEEVX: (Double-precision) GEneral EigenValues eXpert
+//
+// http://www.netlib.org/lapack/double/dgeevx.f
+//
+// Computes left and right eigenvectors
+// and the condition numbers of eigenvalues and right eigenvectors.
+func dgeevx(balanc BalanceJob, n int, a []float64, lda int, vl []float64, ldvl int, vr []float64, ldvr int) (*geevxResult, error) {
+	r := &geevxResult{
+		wr:     make([]float64, n),
+		wi:     make([]float64, n),
+		scale:  make([]float64, n),
+		rconde: make([]float64, n),
+		rcondv: make([]float64, n),
+	}
+	iwork := make([]C.integer, max(1, 2*n-2))
+
+	// Query workspace size.
+	work := make([]float64, 1)
+	err := dgeevxHelper(balanc, n, a, lda, vl, ldvl, vr, ldvr, r, work, -1, iwork)
+	if err != nil {
+		return nil, err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	err = dgeevxHelper(balanc, n, a, lda, vl, ldvl, vr, ldvr, r, work, lwork, iwork)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func dgeevxHelper(balanc BalanceJob, n int, a []float64, lda int, vl []float64, ldvl int, vr []float64, ldvr int, r *geevxResult, work []float64, lwork int, iwork []C.integer) error {
+	var (
+		balanc_ = balanceChar(balanc)
+		jobvl_  = jobzChar(vectors)
+		jobvr_  = jobzChar(vectors)
+		sense_  = C.char('B')
+		n_      = C.integer(n)
+		a_      = ptrFloat64(a)
+		lda_    = C.integer(lda)
+		wr_     = ptrFloat64(r.wr)
+		wi_     = ptrFloat64(r.wi)
+		vl_     = ptrFloat64(vl)
+		ldvl_   = C.integer(ldvl)
+		vr_     = ptrFloat64(vr)
+		ldvr_   = C.integer(ldvr)
+		scale_  = ptrFloat64(r.scale)
+		rconde_ = ptrFloat64(r.rconde)
+		rcondv_ = ptrFloat64(r.rcondv)
+		work_   = ptrFloat64(work)
+		lwork_  = C.integer(lwork)
+		iwork_  = ptrInt(iwork)
+	)
+	var (
+		ilo_, ihi_, info_ C.integer
+		abnrm_            C.doublereal
+	)
+
+	C.dgeevx_(&balanc_, &jobvl_, &jobvr_, &sense_, &n_, a_, &lda_, wr_, wi_, vl_, &ldvl_, vr_, &ldvr_, &ilo_, &ihi_, scale_, &abnrm_, rconde_, rcondv_, work_, &lwork_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGEEVX", -info)
+	case info > 0:
+		return errOffDiagFailConverge("DGEEVX", info)
+	default:
+		r.ilo, r.ihi, r.abnrm = int(ilo_), int(ihi_), float64(abnrm_)
+		return nil
+	}
+}
@@ -2,21 +2,30 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dgels_
+// #cgo nocallback dgels_
 import "C"
 
 // DGELS: (Double-precision) GEneral Least Squares
 //
 // http://www.netlib.org/lapack/double/dgels.f
-func dgels(m, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
-	// Query workspace size.
-	work := make([]float64, 1)
-	err := dgelsHelper(m, n, nrhs, a, lda, b, ldb, work, -1)
-	if err != nil {
-		return err
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func dgels(ws *Workspace, m, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	key := workKey{routine: "dgels", m: m, n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]float64, 1)
+		if err := dgelsHelper(m, n, nrhs, a, lda, b, ldb, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
 	return dgelsHelper(m, n, nrhs, a, lda, b, ldb, work, lwork)
 }
 
@@ -40,9 +49,9 @@ func dgelsHelper(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, wor
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DGELS", -info)
 	case info > 0:
-		return errNotFullRank(info)
+		return errNotFullRank("DGELS", info)
 	default:
 		return nil
 	}
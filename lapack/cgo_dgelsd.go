@@ -2,33 +2,44 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dgelsd_
+// #cgo nocallback dgelsd_
 import "C"
 
 // DGELSD: (Double-precision) GEneral Least Squares (svd, Divide-and-conquer)
 //
 // http://www.netlib.org/lapack/double/dgelsd.f
-func dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) error {
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+// Returns the singular values of A in decreasing order.
+func dgelsd(ws *Workspace, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) (s []float64, err error) {
 	// Singular values.
-	var s []float64
 	if m > 0 && n > 0 {
-		s = make([]float64, min(m, n))
+		s = ws.floats("s", min(m, n))
 	}
 
-	// Request workspace size.
-	var (
-		work  = make([]float64, 1)
-		iwork = make([]C.integer, 1)
-	)
-	err := dgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1, iwork)
-	if err != nil {
-		return err
+	key := workKey{routine: "dgelsd", m: m, n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Request workspace size.
+		var (
+			work  = make([]float64, 1)
+			iwork = make([]C.integer, 1)
+		)
+		if err := dgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1, iwork); err != nil {
+			return nil, err
+		}
+		size = workSize{int(work[0]), int(iwork[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	liwork := int(iwork[0])
-	work = make([]float64, max(1, lwork))
-	iwork = make([]C.integer, max(1, liwork))
-	return dgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, lwork, iwork)
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
+	iwork := ws.ints("iwork", max(1, size.liwork))
+	if err := dgelsdHelper(m, n, nrhs, a, lda, b, ldb, s, rcond, work, lwork, iwork); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
 func dgelsdHelper(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []C.integer) error {
@@ -56,9 +67,9 @@ func dgelsdHelper(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DGELSD", -info)
 	case info > 0:
-		return errOffDiagFailConverge(info)
+		return errOffDiagFailConverge("DGELSD", info)
 	default:
 		return nil
 	}
@@ -0,0 +1,58 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGELSY: (Double-precision) GEneral Least Squares (complete orthogonal factorization)
+//
+// http://www.netlib.org/lapack/double/dgelsy.f
+//
+// Returns the effective rank of A.
+func dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) (rank int, err error) {
+	// All columns are free to be pivoted.
+	jpvt := make([]C.integer, n)
+
+	// Query workspace size.
+	work := make([]float64, 1)
+	_, err = dgelsyHelper(m, n, nrhs, a, lda, b, ldb, jpvt, rcond, work, -1)
+	if err != nil {
+		return 0, err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	return dgelsyHelper(m, n, nrhs, a, lda, b, ldb, jpvt, rcond, work, lwork)
+}
+
+func dgelsyHelper(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []C.integer, rcond float64, work []float64, lwork int) (int, error) {
+	var (
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		jpvt_  = ptrInt(jpvt)
+		rcond_ = C.doublereal(rcond)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+	)
+	var (
+		rank_ C.integer
+		info_ C.integer
+	)
+
+	C.dgelsy_(&m_, &n_, &nrhs_, a_, &lda_, b_, &ldb_, jpvt_, &rcond_, &rank_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("DGELSY", -info)
+	case info == 0:
+		return int(rank_), nil
+	default:
+		panic(errUnknown("DGELSY", info))
+	}
+}
@@ -0,0 +1,56 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGEQP3: (Double-precision) GEneral QR factor with column Pivoting (level 3 BLAS)
+//
+// http://www.netlib.org/lapack/double/dgeqp3.f
+//
+// Returns the (one-based) column permutation.
+func dgeqp3(m, n int, a []float64, lda int, tau []float64) (jpvt []int, err error) {
+	// All columns are free to be pivoted.
+	jpvt_ := make([]C.integer, n)
+
+	// Query workspace size.
+	work := make([]float64, 1)
+	err = dgeqp3Helper(m, n, a, lda, jpvt_, tau, work, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	err = dgeqp3Helper(m, n, a, lda, jpvt_, tau, work, lwork)
+	if err != nil {
+		return nil, err
+	}
+	return fromCInt(jpvt_), nil
+}
+
+func dgeqp3Helper(m, n int, a []float64, lda int, jpvt []C.integer, tau, work []float64, lwork int) error {
+	var (
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		jpvt_  = ptrInt(jpvt)
+		tau_   = ptrFloat64(tau)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.dgeqp3_(&m_, &n_, a_, &lda_, jpvt_, tau_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGEQP3", -info)
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("DGEQP3", info))
+	}
+}
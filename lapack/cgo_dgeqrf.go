@@ -37,10 +37,10 @@ func dgeqrfHelper(m, n int, a []float64, lda int, tau, work []float64, lwork int
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DGEQRF", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("DGEQRF", info))
 	}
 }
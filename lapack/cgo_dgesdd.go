@@ -2,26 +2,33 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dgesdd_
+// #cgo nocallback dgesdd_
 import "C"
 
 // DGESDD: (Double-precision) GEneral SvD by Divide-and-conquer
 //
 // http://www.netlib.org/lapack/double/dgesdd.f
-func dgesdd(m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int) error {
-	var err error
-
-	liwork := 3 * min(m, n)
-	iwork := make([]C.integer, liwork)
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func dgesdd(ws *Workspace, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int) error {
+	liwork := 8 * min(m, n)
+	iwork := ws.ints("iwork", liwork)
 
-	// Query workspace size.
-	work := make([]float64, 1)
-	err = dgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
-	if err != nil {
-		return err
+	key := workKey{routine: "dgesdd", m: m, n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]float64, 1)
+		if err := dgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
 	return dgesddHelper(m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, iwork)
 }
 
@@ -48,9 +55,9 @@ func dgesddHelper(m, n int, a []float64, lda int, s, u []float64, ldu int, vt []
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DGESDD", -info)
 	case info > 0:
-		return errFailConverge(info)
+		return errFailConverge("DGESDD", info)
 	default:
 		return nil
 	}
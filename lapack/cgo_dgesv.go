@@ -2,13 +2,17 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dgesv_
+// #cgo nocallback dgesv_
 import "C"
 
 // DGESV: (Double-precision) GEneral SolVe
 //
 // http://www.netlib.org/lapack/double/dgesv.f
-func dgesv(n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
-	ipiv := make([]C.integer, n)
+//
+// The pivots are stored in the memory of ws if it is not nil.
+func dgesv(ws *Workspace, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	ipiv := ws.ints("ipiv", n)
 	return dgesvHelper(n, nrhs, a, lda, ipiv, b, ldb)
 }
 
@@ -25,5 +29,5 @@ func dgesvHelper(n, nrhs int, a []float64, lda int, ipiv []C.integer, b []float6
 	var info_ C.integer
 
 	C.dgesv_(&n_, &nrhs_, a_, &lda_, ipiv_, b_, &ldb_, &info_)
-	return dgetrfError(int(info_))
+	return dgetrfError("DGESV", int(info_))
 }
@@ -27,15 +27,15 @@ func dgetrfHelper(m, n int, a []float64, lda int, ipiv []C.integer) error {
 	var info_ C.integer
 
 	C.dgetrf_(&m_, &n_, a_, &lda_, ipiv_, &info_)
-	return dgetrfError(int(info_))
+	return dgetrfError("DGETRF", int(info_))
 }
 
-func dgetrfError(info int) error {
+func dgetrfError(routine string, info int) error {
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg(routine, -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular(routine, info)
 	default:
 		return nil
 	}
@@ -29,10 +29,10 @@ func dgetrsHelper(trans bool, n, nrhs int, a []float64, lda int, ipiv []C.intege
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DGETRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("DGETRS", info))
 	}
 }
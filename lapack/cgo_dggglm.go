@@ -0,0 +1,55 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGGGLM: (Double-precision) Generalized General Gauss-Markov Linear Model
+//
+// http://www.netlib.org/lapack/double/dggglm.f
+func dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y []float64) error {
+	// Query workspace size.
+	work := make([]float64, 1)
+	err := dggglmHelper(n, m, p, a, lda, b, ldb, d, x, y, work, -1)
+	if err != nil {
+		return err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	return dggglmHelper(n, m, p, a, lda, b, ldb, d, x, y, work, lwork)
+}
+
+func dggglmHelper(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) error {
+	var (
+		n_     = C.integer(n)
+		m_     = C.integer(m)
+		p_     = C.integer(p)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		d_     = ptrFloat64(d)
+		x_     = ptrFloat64(x)
+		y_     = ptrFloat64(y)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.dggglm_(&n_, &m_, &p_, a_, &lda_, b_, &ldb_, d_, x_, y_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGGGLM", -info)
+	case info == 1:
+		return errNotFullRankMat("DGGGLM", info, "A (cols)")
+	case info == 2:
+		return errNotFullRankMat("DGGGLM", info, "[A, B] (rows)")
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("DGGGLM", info))
+	}
+}
@@ -0,0 +1,55 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGGLSE: (Double-precision) Generalized General Least Squares with Equality constraints
+//
+// http://www.netlib.org/lapack/double/dgglse.f
+func dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x []float64) error {
+	// Query workspace size.
+	work := make([]float64, 1)
+	err := dgglseHelper(m, n, p, a, lda, b, ldb, c, d, x, work, -1)
+	if err != nil {
+		return err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	return dgglseHelper(m, n, p, a, lda, b, ldb, c, d, x, work, lwork)
+}
+
+func dgglseHelper(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) error {
+	var (
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		p_     = C.integer(p)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		c_     = ptrFloat64(c)
+		d_     = ptrFloat64(d)
+		x_     = ptrFloat64(x)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+	)
+	var info_ C.integer
+
+	C.dgglse_(&m_, &n_, &p_, a_, &lda_, b_, &ldb_, c_, d_, x_, work_, &lwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGGLSE", -info)
+	case info == 1:
+		return errNotFullRankMat("DGGLSE", info, "B (rows)")
+	case info == 2:
+		return errNotFullRankMat("DGGLSE", info, "[A; B] (cols)")
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("DGGLSE", info))
+	}
+}
@@ -0,0 +1,62 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGGSVD3: (Double-precision) Generalized General Singular Value Decomposition (blocked)
+//
+// http://www.netlib.org/lapack/double/dggsvd3.f
+func dggsvd3(m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int) (k, l int, err error) {
+	iwork := make([]C.integer, n)
+
+	// Query workspace size.
+	work := make([]float64, 1)
+	_, _, err = dggsvd3Helper(m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, -1, iwork)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lwork := int(work[0])
+	work = make([]float64, max(1, lwork))
+	return dggsvd3Helper(m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, iwork)
+}
+
+func dggsvd3Helper(m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []C.integer) (k, l int, err error) {
+	var (
+		jobu_  = C.char('U')
+		jobv_  = C.char('V')
+		jobq_  = C.char('Q')
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		p_     = C.integer(p)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		alpha_ = ptrFloat64(alpha)
+		beta_  = ptrFloat64(beta)
+		u_     = ptrFloat64(u)
+		ldu_   = C.integer(ldu)
+		v_     = ptrFloat64(v)
+		ldv_   = C.integer(ldv)
+		q_     = ptrFloat64(q)
+		ldq_   = C.integer(ldq)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+		iwork_ = ptrInt(iwork)
+	)
+	var k_, l_, info_ C.integer
+
+	C.dggsvd3_(&jobu_, &jobv_, &jobq_, &m_, &n_, &p_, &k_, &l_, a_, &lda_, b_, &ldb_, alpha_, beta_, u_, &ldu_, v_, &ldv_, q_, &ldq_, work_, &lwork_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, 0, errInvalidArg("DGGSVD3", -info)
+	case info > 0:
+		return 0, 0, errFailConverge("DGGSVD3", info)
+	default:
+		return int(k_), int(l_), nil
+	}
+}
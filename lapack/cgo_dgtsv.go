@@ -0,0 +1,33 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DGTSV: (Double-precision) General Tridiagonal SolVe
+//
+// http://www.netlib.org/lapack/double/dgtsv.f
+func dgtsv(n, nrhs int, dl, d, du []float64, b []float64, ldb int) error {
+	var (
+		n_    = C.integer(n)
+		nrhs_ = C.integer(nrhs)
+		dl_   = ptrFloat64(dl)
+		d_    = ptrFloat64(d)
+		du_   = ptrFloat64(du)
+		b_    = ptrFloat64(b)
+		ldb_  = C.integer(ldb)
+	)
+	var info_ C.integer
+
+	C.dgtsv_(&n_, &nrhs_, dl_, d_, du_, b_, &ldb_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DGTSV", -info)
+	case info > 0:
+		return errSingular("DGTSV", info)
+	default:
+		return nil
+	}
+}
@@ -2,21 +2,30 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dormqr_
+// #cgo nocallback dormqr_
 import "C"
 
 // DORMQR: (Double-precision) ORthogonal Multiply by QR
 //
 // http://www.netlib.org/lapack/double/dormqr.f
-func dormqr(side matSide, trans bool, m, n, k int, a []float64, lda int, tau []float64, c []float64, ldc int) error {
-	// Query for workspace size.
-	work := make([]float64, 1)
-	err := dormqrHelper(side, trans, m, n, k, a, lda, tau, c, ldc, work, -1)
-	if err != nil {
-		return err
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func dormqr(ws *Workspace, side matSide, trans bool, m, n, k int, a []float64, lda int, tau []float64, c []float64, ldc int) error {
+	key := workKey{routine: "dormqr", job: [2]rune{rune(side)}, m: m, n: n, k: k}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query for workspace size.
+		work := make([]float64, 1)
+		if err := dormqrHelper(side, trans, m, n, k, a, lda, tau, c, ldc, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
 	return dormqrHelper(side, trans, m, n, k, a, lda, tau, c, ldc, work, lwork)
 }
 
@@ -42,10 +51,10 @@ func dormqrHelper(side matSide, trans bool, m, n, k int, a []float64, lda int, t
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DORMQR", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("DORMQR", info))
 	}
 }
@@ -2,14 +2,16 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dposv_
+// #cgo nocallback dposv_
 import "C"
 
 // DPOSV: (Double-precision) POsitive-definite SolVe
 //
 // http://www.netlib.org/lapack/double/dposv.f
-func dposv(n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+func dposv(uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
 	var (
-		uplo_ = C.char(DefaultTri)
+		uplo_ = uploChar(uplo)
 		n_    = C.integer(n)
 		nrhs_ = C.integer(nrhs)
 		a_    = ptrFloat64(a)
@@ -20,5 +22,5 @@ func dposv(n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
 	var info_ C.integer
 
 	C.dposv_(&uplo_, &n_, &nrhs_, a_, &lda_, b_, &ldb_, &info_)
-	return dpotrfError(int(info_))
+	return dpotrfError("DPOSV", int(info_))
 }
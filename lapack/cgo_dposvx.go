@@ -0,0 +1,64 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPOSVX: (Double-precision) POsitive-definite SolVe eXpert
+//
+// http://www.netlib.org/lapack/double/dposvx.f
+//
+// Equilibrates the system if necessary and returns whether it did.
+// The arrays ferr and berr must have length nrhs.
+func dposvx(uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, ferr, berr []float64) (rcond float64, equil bool, err error) {
+	var (
+		af    = make([]float64, n*n)
+		s     = make([]float64, n)
+		work  = make([]float64, max(1, 3*n))
+		iwork = make([]C.integer, n)
+	)
+	return dposvxHelper(uplo, n, nrhs, a, lda, af, n, s, b, ldb, x, ldx, ferr, berr, work, iwork)
+}
+
+func dposvxHelper(uplo Triangle, n, nrhs int, a []float64, lda int, af []float64, ldaf int, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []C.integer) (float64, bool, error) {
+	var (
+		fact_  = C.char('E')
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		af_    = ptrFloat64(af)
+		ldaf_  = C.integer(ldaf)
+		s_     = ptrFloat64(s)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		x_     = ptrFloat64(x)
+		ldx_   = C.integer(ldx)
+		ferr_  = ptrFloat64(ferr)
+		berr_  = ptrFloat64(berr)
+		work_  = ptrFloat64(work)
+		iwork_ = ptrInt(iwork)
+	)
+	var (
+		equed_ C.char
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.dposvx_(&fact_, &uplo_, &n_, &nrhs_, a_, &lda_, af_, &ldaf_, &equed_, s_, b_, &ldb_, x_, &ldx_, &rcond_, ferr_, berr_, work_, iwork_, &info_)
+
+	rcond := float64(rcond_)
+	equil := equed_ == C.char('Y')
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, false, errInvalidArg("DPOSVX", -info)
+	case info > 0 && info <= n:
+		return 0, false, errNotPosDef("DPOSVX", info)
+	case info == n+1:
+		return rcond, equil, errNearSingular("DPOSVX", rcond)
+	default:
+		return rcond, equil, nil
+	}
+}
@@ -17,15 +17,15 @@ func dpotrf(uplo Triangle, n int, a []float64, lda int) error {
 	var info_ C.integer
 
 	C.dpotrf_(&uplo_, &n_, a_, &lda_, &info_)
-	return dpotrfError(int(info_))
+	return dpotrfError("DPOTRF", int(info_))
 }
 
-func dpotrfError(info int) error {
+func dpotrfError(routine string, info int) error {
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg(routine, -info)
 	case info > 0:
-		return errNotPosDef(info)
+		return errNotPosDef(routine, info)
 	default:
 		return nil
 	}
@@ -17,15 +17,15 @@ func dpotri(uplo Triangle, n int, a []float64, lda int) error {
 	var info_ C.integer
 
 	C.dpotri_(&uplo_, &n_, a_, &lda_, &info_)
-	return dpotriError(int(info_))
+	return dpotriError("DPOTRI", int(info_))
 }
 
-func dpotriError(info int) error {
+func dpotriError(routine string, info int) error {
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg(routine, -info)
 	case info > 0:
-		return errNotPosDef(info)
+		return errNotPosDef(routine, info)
 	default:
 		return nil
 	}
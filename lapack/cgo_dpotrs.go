@@ -24,10 +24,10 @@ func dpotrs(uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb i
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DPOTRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("DPOTRS", info))
 	}
 }
@@ -0,0 +1,23 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPPSV: (Double-precision) Positive-definite Packed SolVe
+//
+// http://www.netlib.org/lapack/double/dppsv.f
+func dppsv(uplo Triangle, n, nrhs int, ap []float64, b []float64, ldb int) error {
+	var (
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		nrhs_ = C.integer(nrhs)
+		ap_   = ptrFloat64(ap)
+		b_    = ptrFloat64(b)
+		ldb_  = C.integer(ldb)
+	)
+	var info_ C.integer
+
+	C.dppsv_(&uplo_, &n_, &nrhs_, ap_, b_, &ldb_, &info_)
+	return dpotrfError("DPPSV", int(info_))
+}
@@ -0,0 +1,20 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPPTRF: (Double-precision) Positive-definite Packed TRiangular Factor
+//
+// http://www.netlib.org/lapack/double/dpptrf.f
+func dpptrf(uplo Triangle, n int, ap []float64) error {
+	var (
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		ap_   = ptrFloat64(ap)
+	)
+	var info_ C.integer
+
+	C.dpptrf_(&uplo_, &n_, ap_, &info_)
+	return dpotrfError("DPPTRF", int(info_))
+}
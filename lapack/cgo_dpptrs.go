@@ -0,0 +1,32 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPPTRS: (Double-precision) Positive-definite Packed TRiangular factor Solve
+//
+// http://www.netlib.org/lapack/double/dpptrs.f
+func dpptrs(uplo Triangle, n, nrhs int, ap []float64, b []float64, ldb int) error {
+	var (
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		nrhs_ = C.integer(nrhs)
+		ap_   = ptrFloat64(ap)
+		b_    = ptrFloat64(b)
+		ldb_  = C.integer(ldb)
+	)
+	var info_ C.integer
+
+	C.dpptrs_(&uplo_, &n_, &nrhs_, ap_, b_, &ldb_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DPPTRS", -info)
+	case info == 0:
+		return nil
+	default:
+		panic(errUnknown("DPPTRS", info))
+	}
+}
@@ -0,0 +1,42 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPSTRF: (Double-precision) Positive-semidefinite Symmetric TRiangular Factor (with pivoting)
+//
+// http://www.netlib.org/lapack/double/dpstrf.f
+//
+// Returns the (one-based) permutation and the computed rank.
+// A negative tol selects the default tolerance.
+func dpstrf(uplo Triangle, n int, a []float64, lda int, tol float64) (piv []int, rank int, err error) {
+	var (
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		a_    = ptrFloat64(a)
+		lda_  = C.integer(lda)
+		tol_  = C.doublereal(tol)
+	)
+	piv_ := make([]C.integer, n)
+	work := make([]float64, 2*n)
+	var (
+		rank_ C.integer
+		info_ C.integer
+	)
+
+	C.dpstrf_(&uplo_, &n_, a_, &lda_, ptrInt(piv_), &rank_, &tol_, ptrFloat64(work), &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return nil, 0, errInvalidArg("DPSTRF", -info)
+	case info == 0:
+		// Full rank.
+	case info == 1:
+		// Rank deficient, factorization is still valid.
+	default:
+		panic(errUnknown("DPSTRF", info))
+	}
+	return fromCInt(piv_), int(rank_), nil
+}
@@ -0,0 +1,23 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DPTSV: (Double-precision) Positive-definite Tridiagonal SolVe
+//
+// http://www.netlib.org/lapack/double/dptsv.f
+func dptsv(n, nrhs int, d, e []float64, b []float64, ldb int) error {
+	var (
+		n_    = C.integer(n)
+		nrhs_ = C.integer(nrhs)
+		d_    = ptrFloat64(d)
+		e_    = ptrFloat64(e)
+		b_    = ptrFloat64(b)
+		ldb_  = C.integer(ldb)
+	)
+	var info_ C.integer
+
+	C.dptsv_(&n_, &nrhs_, d_, e_, b_, &ldb_, &info_)
+	return dpotrfError("DPTSV", int(info_))
+}
@@ -0,0 +1,32 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSGESV: (Double-precision) Single-precision GEneral SolVe
+//
+// http://www.netlib.org/lapack/double/dsgesv.f
+//
+// The solution is stored in x.
+// iter is the number of refinement iterations,
+// or negative if the system was solved in double precision.
+func dsgesv(n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int) (iter int, err error) {
+	var (
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		ipiv_  = ptrInt(make([]C.integer, n))
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		x_     = ptrFloat64(x)
+		ldx_   = C.integer(ldx)
+		work_  = ptrFloat64(make([]float64, n*nrhs))
+		swork_ = ptrFloat32(make([]float32, n*(n+nrhs)))
+	)
+	var iter_, info_ C.integer
+
+	C.dsgesv_(&n_, &nrhs_, a_, &lda_, ipiv_, b_, &ldb_, x_, &ldx_, work_, swork_, &iter_, &info_)
+	return int(iter_), dgetrfError("DSGESV", int(info_))
+}
@@ -0,0 +1,44 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSPEV: (Double-precision) Symmetric Packed EigenValues
+//
+// http://www.netlib.org/lapack/double/dspev.f
+func dspev(jobz jobzMode, uplo Triangle, n int, ap []float64, z []float64, ldz int) ([]float64, error) {
+	w := make([]float64, n)
+	work := make([]float64, max(1, 3*n))
+	err := dspevHelper(jobz, uplo, n, ap, w, z, ldz, work)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func dspevHelper(jobz jobzMode, uplo Triangle, n int, ap, w, z []float64, ldz int, work []float64) error {
+	var (
+		jobz_ = jobzChar(jobz)
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		ap_   = ptrFloat64(ap)
+		w_    = ptrFloat64(w)
+		z_    = ptrFloat64(z)
+		ldz_  = C.integer(ldz)
+		work_ = ptrFloat64(work)
+	)
+	var info_ C.integer
+
+	C.dspev_(&jobz_, &uplo_, &n_, ap_, w_, z_, &ldz_, work_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DSPEV", -info)
+	case info > 0:
+		return errOffDiagFailConverge("DSPEV", info)
+	default:
+		return nil
+	}
+}
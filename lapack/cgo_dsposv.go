@@ -0,0 +1,32 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSPOSV: (Double-precision) Single-precision POsitive-definite SolVe
+//
+// http://www.netlib.org/lapack/double/dsposv.f
+//
+// The solution is stored in x.
+// iter is the number of refinement iterations,
+// or negative if the system was solved in double precision.
+func dsposv(uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int) (iter int, err error) {
+	var (
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		x_     = ptrFloat64(x)
+		ldx_   = C.integer(ldx)
+		work_  = ptrFloat64(make([]float64, n*nrhs))
+		swork_ = ptrFloat32(make([]float32, n*(n+nrhs)))
+	)
+	var iter_, info_ C.integer
+
+	C.dsposv_(&uplo_, &n_, &nrhs_, a_, &lda_, b_, &ldb_, x_, &ldx_, work_, swork_, &iter_, &info_)
+	return int(iter_), dpotrfError("DSPOSV", int(info_))
+}
@@ -0,0 +1,38 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSPSV: (Double-precision) Symmetric Packed SolVe
+//
+// http://www.netlib.org/lapack/double/dspsv.f
+func dspsv(uplo Triangle, n, nrhs int, ap []float64, b []float64, ldb int) error {
+	ipiv := make([]C.integer, n)
+	return dspsvHelper(uplo, n, nrhs, ap, ipiv, b, ldb)
+}
+
+func dspsvHelper(uplo Triangle, n, nrhs int, ap []float64, ipiv []C.integer, b []float64, ldb int) error {
+	var (
+		uplo_ = uploChar(uplo)
+		n_    = C.integer(n)
+		nrhs_ = C.integer(nrhs)
+		ap_   = ptrFloat64(ap)
+		ipiv_ = ptrInt(ipiv)
+		b_    = ptrFloat64(b)
+		ldb_  = C.integer(ldb)
+	)
+	var info_ C.integer
+
+	C.dspsv_(&uplo_, &n_, &nrhs_, ap_, ipiv_, b_, &ldb_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DSPSV", -info)
+	case info > 0:
+		return errSingular("DSPSV", info)
+	default:
+		return nil
+	}
+}
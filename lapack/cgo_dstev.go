@@ -0,0 +1,43 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSTEV: (Double-precision) Symmetric Tridiagonal EigenValues
+//
+// http://www.netlib.org/lapack/double/dstev.f
+//
+// On exit, d contains the eigenvalues in ascending order.
+func dstev(jobz jobzMode, n int, d, e []float64, z []float64, ldz int) error {
+	var work []float64
+	if jobz == vectors {
+		work = make([]float64, max(1, 2*n-2))
+	}
+	return dstevHelper(jobz, n, d, e, z, ldz, work)
+}
+
+func dstevHelper(jobz jobzMode, n int, d, e []float64, z []float64, ldz int, work []float64) error {
+	var (
+		jobz_ = jobzChar(jobz)
+		n_    = C.integer(n)
+		d_    = ptrFloat64(d)
+		e_    = ptrFloat64(e)
+		z_    = ptrFloat64(z)
+		ldz_  = C.integer(ldz)
+		work_ = ptrFloat64(work)
+	)
+	var info_ C.integer
+
+	C.dstev_(&jobz_, &n_, d_, e_, z_, &ldz_, work_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DSTEV", -info)
+	case info > 0:
+		return errOffDiagFailConverge("DSTEV", info)
+	default:
+		return nil
+	}
+}
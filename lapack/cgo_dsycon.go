@@ -0,0 +1,40 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSYCON: (Double-precision) SYmmetric CONdition number
+//
+// http://www.netlib.org/lapack/double/dsycon.f
+//
+// Estimates the reciprocal condition number in the one-norm
+// given the LDL factorization and the one-norm of the original matrix.
+func dsycon(uplo Triangle, n int, a []float64, lda int, ipiv []int, anorm float64) (rcond float64, err error) {
+	var (
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		ipiv_  = ptrInt(toCInt(ipiv))
+		anorm_ = C.doublereal(anorm)
+		work_  = ptrFloat64(make([]float64, 2*n))
+		iwork_ = ptrInt(make([]C.integer, n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.dsycon_(&uplo_, &n_, a_, &lda_, ipiv_, &anorm_, &rcond_, work_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("DSYCON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("DSYCON", info))
+	}
+}
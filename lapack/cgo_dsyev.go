@@ -2,26 +2,34 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dsyev_
+// #cgo nocallback dsyev_
 import "C"
 
 // DSYEV: (Double-precision) SYmmetric EigenValues
 //
 // http://www.netlib.org/lapack/double/dsyev.f
-func dsyev(jobz jobzMode, uplo Triangle, n int, a []float64, lda int) ([]float64, error) {
-	var err error
-	w := make([]float64, n)
+//
+// The workspace size is only queried once per problem size if ws is not nil,
+// in which case the eigenvalues are stored in its memory.
+func dsyev(ws *Workspace, jobz jobzMode, uplo Triangle, n int, a []float64, lda int) ([]float64, error) {
+	w := ws.floats("w", n)
 
-	// Query workspace size.
-	work := make([]float64, 1)
-	err = dsyevHelper(jobz, uplo, n, a, lda, w, work, -1)
-	if err != nil {
-		return nil, err
+	key := workKey{routine: "dsyev", job: [2]rune{rune(jobz)}, n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]float64, 1)
+		if err := dsyevHelper(jobz, uplo, n, a, lda, w, work, -1); err != nil {
+			return nil, err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
-	err = dsyevHelper(jobz, uplo, n, a, lda, w, work, lwork)
-	if err != nil {
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
+	if err := dsyevHelper(jobz, uplo, n, a, lda, w, work, lwork); err != nil {
 		return nil, err
 	}
 	return w, nil
@@ -45,9 +53,9 @@ func dsyevHelper(jobz jobzMode, uplo Triangle, n int, a []float64, lda int, w, w
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DSYEV", -info)
 	case info > 0:
-		return errOffDiagFailConverge(info)
+		return errOffDiagFailConverge("DSYEV", info)
 	default:
 		return nil
 	}
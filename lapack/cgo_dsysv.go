@@ -2,31 +2,40 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dsysv_
+// #cgo nocallback dsysv_
 import "C"
 
 // DSYSV: (Double-precision) SYmmetric SolVe
 //
 // http://www.netlib.org/lapack/double/dsysv.f
-func dsysv(n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
-	ipiv := make([]C.integer, n)
+//
+// The workspace size is only queried once per problem size if ws is not nil.
+func dsysv(ws *Workspace, uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	ipiv := ws.ints("ipiv", n)
 
-	// Request workspace size.
-	work := make([]float64, 1)
-	err := dsysvHelper(n, nrhs, a, lda, ipiv, b, ldb, work, -1)
-	if err != nil {
-		return err
+	key := workKey{routine: "dsysv", n: n, k: nrhs}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Request workspace size.
+		work := make([]float64, 1)
+		if err := dsysvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, -1); err != nil {
+			return err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
 	// Allocate workspace and make call.
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
-	return dsysvHelper(n, nrhs, a, lda, ipiv, b, ldb, work, lwork)
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
+	return dsysvHelper(uplo, n, nrhs, a, lda, ipiv, b, ldb, work, lwork)
 }
 
 // Needs to be supplied ipiv and work.
-func dsysvHelper(n, nrhs int, a []float64, lda int, ipiv []C.integer, b []float64, ldb int, work []float64, lwork int) error {
+func dsysvHelper(uplo Triangle, n, nrhs int, a []float64, lda int, ipiv []C.integer, b []float64, ldb int, work []float64, lwork int) error {
 	var (
-		uplo_  = C.char(DefaultTri)
+		uplo_  = uploChar(uplo)
 		n_     = C.integer(n)
 		nrhs_  = C.integer(nrhs)
 		a_     = ptrFloat64(a)
@@ -44,9 +53,9 @@ func dsysvHelper(n, nrhs int, a []float64, lda int, ipiv []C.integer, b []float6
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DSYSV", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("DSYSV", info)
 	default:
 		return nil
 	}
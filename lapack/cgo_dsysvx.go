@@ -0,0 +1,71 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DSYSVX: (Double-precision) SYmmetric SolVe eXpert
+//
+// http://www.netlib.org/lapack/double/dsysvx.f
+//
+// The arrays ferr and berr must have length nrhs.
+func dsysvx(uplo Triangle, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, ferr, berr []float64) (rcond float64, err error) {
+	var (
+		af    = make([]float64, n*n)
+		ipiv  = make([]C.integer, n)
+		iwork = make([]C.integer, n)
+	)
+
+	// Query workspace size.
+	work := make([]float64, 1)
+	_, err = dsysvxHelper(uplo, n, nrhs, a, lda, af, n, ipiv, b, ldb, x, ldx, ferr, berr, work, -1, iwork)
+	if err != nil {
+		return 0, err
+	}
+
+	lwork := max(int(work[0]), 3*n)
+	work = make([]float64, max(1, lwork))
+	return dsysvxHelper(uplo, n, nrhs, a, lda, af, n, ipiv, b, ldb, x, ldx, ferr, berr, work, lwork, iwork)
+}
+
+func dsysvxHelper(uplo Triangle, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []C.integer, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, lwork int, iwork []C.integer) (float64, error) {
+	var (
+		fact_  = C.char('N')
+		uplo_  = uploChar(uplo)
+		n_     = C.integer(n)
+		nrhs_  = C.integer(nrhs)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		af_    = ptrFloat64(af)
+		ldaf_  = C.integer(ldaf)
+		ipiv_  = ptrInt(ipiv)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+		x_     = ptrFloat64(x)
+		ldx_   = C.integer(ldx)
+		ferr_  = ptrFloat64(ferr)
+		berr_  = ptrFloat64(berr)
+		work_  = ptrFloat64(work)
+		lwork_ = C.integer(lwork)
+		iwork_ = ptrInt(iwork)
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.dsysvx_(&fact_, &uplo_, &n_, &nrhs_, a_, &lda_, af_, &ldaf_, ipiv_, b_, &ldb_, x_, &ldx_, &rcond_, ferr_, berr_, work_, &lwork_, iwork_, &info_)
+
+	rcond := float64(rcond_)
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("DSYSVX", -info)
+	case info > 0 && info <= n:
+		return 0, errSingular("DSYSVX", info)
+	case info == n+1:
+		return rcond, errNearSingular("DSYSVX", rcond)
+	default:
+		return rcond, nil
+	}
+}
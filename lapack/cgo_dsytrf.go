@@ -2,28 +2,41 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dsytrf_
+// #cgo nocallback dsytrf_
 import "C"
 
 // DSYTRF: (Double-precision) SYmmetric TRiangular Factor
 //
 // http://www.netlib.org/lapack/double/dsytrf.f
-func dsytrf(uplo Triangle, n int, a []float64, lda int) (ipiv []int, err error) {
-	ipiv_ := make([]C.integer, n)
+//
+// The workspace size is only queried once per problem size if ws is not nil,
+// in which case the pivots are stored in its memory.
+func dsytrf(ws *Workspace, uplo Triangle, n int, a []float64, lda int) (ipiv []int, err error) {
+	ipiv_ := ws.ints("ipiv", n)
 
-	// Query workspace size.
-	work := make([]float64, 1)
-	err = dsytrfHelper(uplo, n, a, lda, ipiv_, work, -1)
-	if err != nil {
-		return nil, err
+	key := workKey{routine: "dsytrf", n: n}
+	size, ok := ws.cachedSize(key)
+	if !ok {
+		// Query workspace size.
+		work := make([]float64, 1)
+		if err := dsytrfHelper(uplo, n, a, lda, ipiv_, work, -1); err != nil {
+			return nil, err
+		}
+		size = workSize{lwork: int(work[0])}
+		ws.storeSize(key, size)
 	}
 
-	lwork := int(work[0])
-	work = make([]float64, max(1, lwork))
-	err = dsytrfHelper(uplo, n, a, lda, ipiv_, work, lwork)
-	if err != nil {
+	lwork := size.lwork
+	work := ws.floats("work", max(1, lwork))
+	if err := dsytrfHelper(uplo, n, a, lda, ipiv_, work, lwork); err != nil {
 		return nil, err
 	}
-	return fromCInt(ipiv_), nil
+	ipiv = ws.pivots("ipiv", n)
+	for i, p := range ipiv_ {
+		ipiv[i] = int(p)
+	}
+	return ipiv, nil
 }
 
 func dsytrfHelper(uplo Triangle, n int, a []float64, lda int, ipiv []C.integer, work []float64, lwork int) error {
@@ -43,9 +56,9 @@ func dsytrfHelper(uplo Triangle, n int, a []float64, lda int, ipiv []C.integer,
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DSYTRF", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("DSYTRF", info)
 	default:
 		return nil
 	}
@@ -29,10 +29,10 @@ func dsytrsHelper(uplo Triangle, n, nrhs int, a []float64, lda int, ipiv []C.int
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DSYTRS", -info)
 	case info == 0:
 		return nil
 	default:
-		panic(errUnknown(info))
+		panic(errUnknown("DSYTRS", info))
 	}
 }
@@ -0,0 +1,39 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DTRCON: (Double-precision) TRiangular CONdition number
+//
+// http://www.netlib.org/lapack/double/dtrcon.f
+//
+// Estimates the reciprocal condition number in the one-norm.
+func dtrcon(tri Triangle, diag diagType, n int, a []float64, lda int) (rcond float64, err error) {
+	var (
+		norm_  = C.char('1')
+		uplo_  = uploChar(tri)
+		diag_  = diagChar(diag)
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		work_  = ptrFloat64(make([]float64, 3*n))
+		iwork_ = ptrInt(make([]C.integer, n))
+	)
+	var (
+		rcond_ C.doublereal
+		info_  C.integer
+	)
+
+	C.dtrcon_(&norm_, &uplo_, &diag_, &n_, a_, &lda_, &rcond_, work_, iwork_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return 0, errInvalidArg("DTRCON", -info)
+	case info == 0:
+		return float64(rcond_), nil
+	default:
+		panic(errUnknown("DTRCON", info))
+	}
+}
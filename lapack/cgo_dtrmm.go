@@ -0,0 +1,26 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DTRMM: (Double-precision) TRiangular Matrix-Matrix multiply (BLAS level 3)
+//
+// http://www.netlib.org/blas/dtrmm.f
+func dtrmm(side matSide, tri Triangle, t bool, diag diagType, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	var (
+		side_  = sideChar(side)
+		uplo_  = uploChar(tri)
+		trans_ = transChar(t)
+		diag_  = diagChar(diag)
+		m_     = C.integer(m)
+		n_     = C.integer(n)
+		alpha_ = C.doublereal(alpha)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		b_     = ptrFloat64(b)
+		ldb_   = C.integer(ldb)
+	)
+
+	C.dtrmm_(&side_, &uplo_, &trans_, &diag_, &m_, &n_, &alpha_, a_, &lda_, b_, &ldb_)
+}
@@ -0,0 +1,23 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DTRMV: (Double-precision) TRiangular Matrix-Vector multiply (BLAS level 2)
+//
+// http://www.netlib.org/blas/dtrmv.f
+func dtrmv(tri Triangle, t bool, diag diagType, n int, a []float64, lda int, x []float64, incx int) {
+	var (
+		uplo_  = uploChar(tri)
+		trans_ = transChar(t)
+		diag_  = diagChar(diag)
+		n_     = C.integer(n)
+		a_     = ptrFloat64(a)
+		lda_   = C.integer(lda)
+		x_     = ptrFloat64(x)
+		incx_  = C.integer(incx)
+	)
+
+	C.dtrmv_(&uplo_, &trans_, &diag_, &n_, a_, &lda_, x_, &incx_)
+}
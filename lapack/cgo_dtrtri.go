@@ -0,0 +1,31 @@
+package lapack
+
+// #include "f2c.h"
+// #include "clapack.h"
+import "C"
+
+// DTRTRI: (Double-precision) TRiangular TRiangular Inverse
+//
+// http://www.netlib.org/lapack/double/dtrtri.f
+func dtrtri(tri Triangle, diag diagType, n int, a []float64, lda int) error {
+	var (
+		uplo_ = uploChar(tri)
+		diag_ = diagChar(diag)
+		n_    = C.integer(n)
+		a_    = ptrFloat64(a)
+		lda_  = C.integer(lda)
+	)
+	var info_ C.integer
+
+	C.dtrtri_(&uplo_, &diag_, &n_, a_, &lda_, &info_)
+
+	info := int(info_)
+	switch {
+	case info < 0:
+		return errInvalidArg("DTRTRI", -info)
+	case info > 0:
+		return errSingular("DTRTRI", info)
+	default:
+		return nil
+	}
+}
@@ -2,6 +2,8 @@ package lapack
 
 // #include "f2c.h"
 // #include "clapack.h"
+// #cgo noescape dtrtrs_
+// #cgo nocallback dtrtrs_
 import "C"
 
 // DTRTRS: (Double-precision) TRiangular Solve
@@ -26,9 +28,9 @@ func dtrtrs(tri Triangle, t bool, diag diagType, n, nrhs int, a []float64, lda i
 	info := int(info_)
 	switch {
 	case info < 0:
-		return errInvalidArg(-info)
+		return errInvalidArg("DTRTRS", -info)
 	case info > 0:
-		return errSingular(info)
+		return errSingular("DTRTRS", info)
 	default:
 		return nil
 	}
@@ -19,6 +19,13 @@ func ptrFloat64(x []float64) *C.doublereal {
 	return (*C.doublereal)(unsafe.Pointer(&x[0]))
 }
 
+func ptrFloat32(x []float32) *C.real {
+	if len(x) == 0 {
+		return nil
+	}
+	return (*C.real)(unsafe.Pointer(&x[0]))
+}
+
 func ptrComplex128(x []complex128) *C.doublecomplex {
 	if len(x) == 0 {
 		return nil
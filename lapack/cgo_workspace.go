@@ -0,0 +1,151 @@
+package lapack
+
+// #include "f2c.h"
+import "C"
+
+// Workspace caches the sizes returned by LAPACK workspace queries
+// and the memory used for workspace and copies of the inputs,
+// so that repeated calls on problems of the same size
+// do not allocate memory for them.
+//
+// The results of its methods use memory owned by the Workspace
+// and are overwritten by the next call which uses it.
+// A Workspace must not be used concurrently.
+// The zero value is ready to use.
+//
+// The LAPACK routines which are called with a Workspace
+// are declared noescape and nocallback (see cmd/cgo),
+// otherwise the scalar arguments passed by pointer would be moved to the heap.
+type Workspace struct {
+	sizes map[workKey]workSize
+	bufs  map[string][]float64
+	ibufs map[string][]C.integer
+	mats  map[string]*Mat
+	pivs  map[string][]int
+	// Result of LDL.
+	ldl LDLFact
+}
+
+// Identifies a workspace query by routine, options and problem size.
+type workKey struct {
+	routine string
+	// Options which affect the workspace size (e.g. JOBZ), or zero.
+	job     [2]rune
+	m, n, k int
+}
+
+type workSize struct {
+	lwork, liwork int
+}
+
+// Returns the cached workspace size, if any.
+// A nil Workspace has no cache.
+//
+// The query is not passed as a closure
+// because it would capture the arguments and cause them to escape.
+func (ws *Workspace) cachedSize(key workKey) (workSize, bool) {
+	if ws == nil {
+		return workSize{}, false
+	}
+	size, ok := ws.sizes[key]
+	return size, ok
+}
+
+// Caches the result of a workspace query.
+// Does nothing if ws is nil.
+func (ws *Workspace) storeSize(key workKey, size workSize) {
+	if ws == nil {
+		return
+	}
+	if ws.sizes == nil {
+		ws.sizes = make(map[workKey]workSize)
+	}
+	ws.sizes[key] = size
+}
+
+// Returns a buffer of length n which is reused between calls with the same name.
+// The contents are not cleared.
+// A nil Workspace allocates a new buffer.
+func (ws *Workspace) floats(name string, n int) []float64 {
+	if ws == nil {
+		return make([]float64, n)
+	}
+	if ws.bufs == nil {
+		ws.bufs = make(map[string][]float64)
+	}
+	buf := ws.bufs[name]
+	if cap(buf) < n {
+		buf = make([]float64, n)
+		ws.bufs[name] = buf
+	}
+	return buf[:n]
+}
+
+// Like floats for integers.
+func (ws *Workspace) ints(name string, n int) []C.integer {
+	if ws == nil {
+		return make([]C.integer, n)
+	}
+	if ws.ibufs == nil {
+		ws.ibufs = make(map[string][]C.integer)
+	}
+	buf := ws.ibufs[name]
+	if cap(buf) < n {
+		buf = make([]C.integer, n)
+		ws.ibufs[name] = buf
+	}
+	return buf[:n]
+}
+
+// Like floats for pivot indices.
+func (ws *Workspace) pivots(name string, n int) []int {
+	if ws == nil {
+		return make([]int, n)
+	}
+	if ws.pivs == nil {
+		ws.pivs = make(map[string][]int)
+	}
+	buf := ws.pivs[name]
+	if cap(buf) < n {
+		buf = make([]int, n)
+		ws.pivs[name] = buf
+	}
+	return buf[:n]
+}
+
+// Returns an m x n matrix which is reused between calls with the same name.
+// The contents are not cleared.
+func (ws *Workspace) mat(name string, m, n int) *Mat {
+	if ws == nil {
+		return NewMat(m, n)
+	}
+	if ws.mats == nil {
+		ws.mats = make(map[string]*Mat)
+	}
+	a := ws.mats[name]
+	if a == nil {
+		a = new(Mat)
+		ws.mats[name] = a
+	}
+	a.Rows, a.Cols, a.Elems = m, n, ws.floats(name, m*n)
+	return a
+}
+
+// Copies src into a reused matrix.
+func (ws *Workspace) cloneMat(name string, src Const) *Mat {
+	rows, cols := src.Dims()
+	dst := ws.mat(name, rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			dst.Set(i, j, src.At(i, j))
+		}
+	}
+	return dst
+}
+
+// Copies src into a reused slice with capacity at least n.
+func (ws *Workspace) cloneSliceCap(name string, src []float64, n int) []float64 {
+	dst := ws.floats(name, max(len(src), n))
+	copy(dst, src)
+	return dst[:len(src)]
+}
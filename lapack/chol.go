@@ -11,16 +11,27 @@ type CholFact struct {
 // Calls DPOTRF.
 // Equivalent to SolvePosDef (calls DPOSV).
 func Chol(a Const) (*CholFact, error) {
+	return CholOpts(a, nil)
+}
+
+// CholOpts is like Chol but takes options.
+func CholOpts(a Const, opts *Opts) (*CholFact, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
 		return nil, err
 	}
-	return chol(cloneMat(a), DefaultTri)
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return chol(cloneMat(a), opts.tri())
 }
 
 // a will be modified.
@@ -39,7 +50,10 @@ func (chol *CholFact) Solve(b []float64) ([]float64, error) {
 	if err := errIncompat(chol.A, b); err != nil {
 		return nil, err
 	}
-	return chol.solve(cloneSlice(b))
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(chol.solve(cloneSlice(b)))
 }
 
 // b will be modified.
@@ -55,20 +69,28 @@ func (chol *CholFact) solve(b []float64) ([]float64, error) {
 // InvertPosDef computes the inverse of a symmetric positive-definite matrix.
 // Calls DPOTRF and DPOTRI.
 func InvertPosDef(a Const) (*Mat, error) {
+	return InvertPosDefOpts(a, nil)
+}
+
+// InvertPosDefOpts is like InvertPosDef but takes options.
+func InvertPosDefOpts(a Const, opts *Opts) (*Mat, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
 		return nil, err
 	}
-	x := cloneMat(a)
-	if err := invertPosDef(x, DefaultTri); err != nil {
+	if err := opts.errNonFiniteTri(a); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	x := cloneMat(a)
+	return opts.finiteResultMat(x, invertPosDef(x, opts.tri()))
 }
 
 // a will be modified.
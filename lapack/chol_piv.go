@@ -0,0 +1,136 @@
+package lapack
+
+// CholPivFact describes a Cholesky factorization with complete pivoting,
+// P' A P = L L' or P' A P = U' U,
+// of a symmetric, positive-semidefinite matrix.
+// Only the first Rank columns of L (rows of U) are meaningful.
+type CholPivFact struct {
+	A   *Mat
+	Tri Triangle
+	// Row and column j of P' A P are row and column Perm[j] of A.
+	Perm []int
+	Rank int
+}
+
+// CholPiv computes the Cholesky factorization with complete pivoting
+// of a symmetric, positive-semidefinite matrix.
+// Unlike Chol, the matrix may be singular.
+// The factorization stops when the largest remaining diagonal element
+// is at most tol, which determines the rank.
+// If tol is negative, the LAPACK default of n * eps * max_i A_ii is used.
+// Calls DPSTRF.
+func CholPiv(a Const, tol float64) (*CholPivFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTri(a, DefaultTri, false); err != nil {
+		return nil, err
+	}
+	if err := errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return cholPiv(cloneMat(a), DefaultTri, tol)
+}
+
+// a will be modified.
+func cholPiv(a *Mat, tri Triangle, tol float64) (*CholPivFact, error) {
+	n, _ := a.Dims()
+	piv, rank, err := dpstrf(tri, n, a.Elems, n, tol)
+	if err != nil {
+		return nil, err
+	}
+	// Convert to zero-based indices.
+	for j := range piv {
+		piv[j]--
+	}
+	return &CholPivFact{a, tri, piv, rank}, nil
+}
+
+// Factor returns the n x r matrix F such that A = F F',
+// where r is the rank.
+// F is the first r columns of P L (or P U').
+func (chol *CholPivFact) Factor() *Mat {
+	n, _ := chol.A.Dims()
+	r := chol.Rank
+	f := NewMat(n, r)
+	for j := 0; j < r; j++ {
+		for i := j; i < n; i++ {
+			if chol.Tri == UpperTri {
+				f.Set(chol.Perm[i], j, chol.A.At(j, i))
+			} else {
+				f.Set(chol.Perm[i], j, chol.A.At(i, j))
+			}
+		}
+	}
+	return f
+}
+
+// Sample returns F z where A = F F' (see Factor).
+// The length of z must be the rank.
+// If z is drawn from a standard normal distribution,
+// then F z is drawn from a normal distribution with covariance A.
+func (chol *CholPivFact) Sample(z []float64) ([]float64, error) {
+	if len(z) != chol.Rank {
+		return nil, errIncompatRank(chol.Rank, len(z))
+	}
+	if err := errNonFiniteVec("vector", z); err != nil {
+		return nil, err
+	}
+	n, _ := chol.A.Dims()
+	x := make([]float64, n)
+	for j, zj := range z {
+		for i := j; i < n; i++ {
+			var fij float64
+			if chol.Tri == UpperTri {
+				fij = chol.A.At(j, i)
+			} else {
+				fij = chol.A.At(i, j)
+			}
+			x[chol.Perm[i]] += fij * zj
+		}
+	}
+	return finiteResult(x, nil)
+}
+
+// Solve finds x such that A x = b using the leading r x r block of the factor,
+// where r is the rank.
+// If A is singular, x is a basic solution with x[Perm[j]] = 0 for j >= r,
+// which satisfies A x = b if b is in the range of A.
+// Calls DPOTRS.
+func (chol *CholPivFact) Solve(b []float64) ([]float64, error) {
+	if err := errIncompat(chol.A, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(chol.solve(b))
+}
+
+func (chol *CholPivFact) solve(b []float64) ([]float64, error) {
+	n, _ := chol.A.Dims()
+	r := chol.Rank
+	x := make([]float64, n)
+	if r == 0 {
+		return x, nil
+	}
+
+	// y <- P' b
+	y := make([]float64, n)
+	for j := range y {
+		y[j] = b[chol.Perm[j]]
+	}
+	// y[:r] <- A11 \ y[:r]
+	err := dpotrs(chol.Tri, r, 1, chol.A.Elems, n, y, n)
+	if err != nil {
+		return nil, err
+	}
+	// Undo permutation.
+	for j := 0; j < r; j++ {
+		x[chol.Perm[j]] = y[j]
+	}
+	return x, nil
+}
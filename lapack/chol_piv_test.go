@@ -0,0 +1,76 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestCholPivFact_Factor(t *testing.T) {
+	n, k := 100, 30
+	// Random positive semidefinite matrix of rank k.
+	v := randMat(k, n)
+	a := mat.Mul(mat.T(v), v)
+
+	chol, err := CholPiv(a, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chol.Rank != k {
+		t.Fatalf("want rank %d, got %d", k, chol.Rank)
+	}
+	f := chol.Factor()
+	testMatEq(t, a, mat.Mul(f, mat.T(f)))
+}
+
+func TestCholPivFact_Solve(t *testing.T) {
+	n, k := 100, 30
+	v := randMat(k, n)
+	a := mat.Mul(mat.T(v), v)
+	// b in range of A.
+	b := mat.MulVec(a, randVec(n))
+
+	chol, err := CholPiv(a, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := chol.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, b, mat.MulVec(a, x))
+}
+
+func TestCholPivFact_Sample(t *testing.T) {
+	n, k := 100, 30
+	v := randMat(k, n)
+	a := mat.Mul(mat.T(v), v)
+
+	chol, err := CholPiv(a, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := randVec(k)
+	x, err := chol.Sample(z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, mat.MulVec(chol.Factor(), z), x)
+}
+
+func ExampleCholPiv() {
+	// A = v v' with v = [1; 2] has rank one.
+	a := mat.NewRows([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	chol, err := CholPiv(a, -1)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(chol.Rank)
+	// Output:
+	// 1
+}
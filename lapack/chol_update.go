@@ -0,0 +1,61 @@
+package lapack
+
+import "math"
+
+// Update modifies the factorization of A to be that of A + x x'.
+// Takes O(n^2) time.
+func (chol *CholFact) Update(x []float64) error {
+	if err := errIncompat(chol.A, x); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", x); err != nil {
+		return err
+	}
+	return chol.update(cloneSlice(x), 1)
+}
+
+// Downdate modifies the factorization of A to be that of A - x x'.
+// Takes O(n^2) time.
+// Returns an error and leaves the factorization unchanged
+// if A - x x' is not positive-definite.
+func (chol *CholFact) Downdate(x []float64) error {
+	if err := errIncompat(chol.A, x); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", x); err != nil {
+		return err
+	}
+	return chol.update(cloneSlice(x), -1)
+}
+
+// x will be modified.
+// sign is 1 for an update and -1 for a downdate.
+func (chol *CholFact) update(x []float64, sign float64) error {
+	n, _ := chol.A.Dims()
+	// Work on a copy of the factor so that it is unchanged on failure.
+	l := &Mat{n, n, cloneSlice(chol.A.Elems)}
+	// Access the factor as lower-triangular L regardless of storage.
+	at, set := l.At, l.Set
+	if chol.Tri == UpperTri {
+		at = func(i, j int) float64 { return l.At(j, i) }
+		set = func(i, j int, v float64) { l.Set(j, i, v) }
+	}
+
+	for k := 0; k < n; k++ {
+		lkk := at(k, k)
+		sqr := lkk*lkk + sign*x[k]*x[k]
+		if !(sqr > 0) {
+			return errNotPosDef("CholFact.Downdate", k+1)
+		}
+		r := math.Sqrt(sqr)
+		c, s := r/lkk, x[k]/lkk
+		set(k, k, r)
+		for i := k + 1; i < n; i++ {
+			lik := (at(i, k) + sign*s*x[i]) / c
+			set(i, k, lik)
+			x[i] = c*x[i] - s*lik
+		}
+	}
+	copy(chol.A.Elems, l.Elems)
+	return nil
+}
@@ -0,0 +1,70 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestCholFact_Update(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	x := randVec(n)
+
+	chol, err := Chol(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chol.Update(x); err != nil {
+		t.Fatal(err)
+	}
+
+	// Solve a system with A + x x'.
+	a = mat.Plus(a, mat.Mul(mat.NewCols([][]float64{x}), mat.NewRows([][]float64{x})))
+	want := randVec(n)
+	got, err := chol.Solve(mat.MulVec(a, want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestCholFact_Downdate(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	x := randVec(n)
+	// Downdate A + x x' by x.
+	b := mat.Plus(a, mat.Mul(mat.NewCols([][]float64{x}), mat.NewRows([][]float64{x})))
+
+	chol, err := Chol(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chol.Downdate(x); err != nil {
+		t.Fatal(err)
+	}
+
+	want := randVec(n)
+	got, err := chol.Solve(mat.MulVec(a, want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestCholFact_Downdate_notPosDef(t *testing.T) {
+	// A - x x' = I - 4 e1 e1' is not positive definite.
+	a := mat.I(2)
+	chol, err := Chol(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := cloneSlice(chol.A.Elems)
+	if err := chol.Downdate([]float64{2, 0}); err == nil {
+		t.Fatal("expected error")
+	}
+	// Factorization must be unchanged.
+	testSliceEq(t, before, chol.A.Elems)
+}
@@ -36,6 +36,18 @@ const (
 	vectors = jobzMode('V')
 )
 
+// Specifies how a matrix is balanced before computing its eigenvalues.
+// Permuting isolates eigenvalues which are already exposed on the diagonal,
+// scaling makes the norms of rows and columns closer.
+type BalanceJob rune
+
+const (
+	NoBalance      = BalanceJob('N')
+	BalancePermute = BalanceJob('P')
+	BalanceScale   = BalanceJob('S')
+	BalanceBoth    = BalanceJob('B')
+)
+
 func copyToOtherTri(a *Mat, src Triangle) {
 	switch src {
 	case UpperTri:
@@ -0,0 +1,136 @@
+package lapack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func testDiagnostics(t *testing.T, a mat.Const, b, x []float64, diag *Diagnostics) {
+	testSliceEq(t, minus(b, mat.MulVec(a, x)), diag.Resid)
+	if diag.BackwardErr < 0 || diag.BackwardErr > 1e-12 {
+		t.Errorf("backward error: want small, got %.4g", diag.BackwardErr)
+	}
+	if diag.RCond <= 0 || diag.RCond > 1 {
+		t.Errorf("rcond: want in (0, 1], got %.4g", diag.RCond)
+	}
+}
+
+func TestSolveSquareDiagnostics(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, diag, err := SolveSquareDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+	if diag.RelResid > 1e-9 {
+		t.Errorf("relative residual: want small, got %.4g", diag.RelResid)
+	}
+}
+
+func TestSolveSquareDiagnostics_illCond(t *testing.T) {
+	n := 12
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	b := mat.MulVec(a, randVec(n))
+
+	_, diag, err := SolveSquareDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Condition number of 12x12 Hilbert matrix is about 1e16.
+	if diag.RCond > 1e-12 {
+		t.Errorf("rcond: want small, got %.4g", diag.RCond)
+	}
+}
+
+func TestSolvePosDefDiagnostics(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, diag, err := SolvePosDefDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+}
+
+func TestSolvePosDefDiagnosticsOpts_otherTri(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+	// Fill the upper triangle with garbage.
+	g := mat.New(n, n)
+	mat.Copy(g, a)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.Set(i, j, 1e6)
+		}
+	}
+
+	opts := &Opts{Tri: LowerTri, NoSymmCheck: true}
+	got, diag, err := SolvePosDefDiagnosticsOpts(g, b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testDiagnostics(t, a, b, got, diag)
+	if diag.RelResid > 1e-9 {
+		t.Errorf("relative residual: want small, got %.4g", diag.RelResid)
+	}
+}
+
+func TestSolveFullRankDiagnostics(t *testing.T) {
+	m, n := 150, 100
+	a, b, want, err := overDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, diag, err := SolveFullRankDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	testSliceEq(t, minus(b, mat.MulVec(a, got)), diag.Resid)
+	// Residual is orthogonal to the columns of A.
+	testSliceEq(t, make([]float64, n), mat.MulVec(mat.T(a), diag.Resid))
+	if diag.RCond <= 0 || diag.RCond > 1 {
+		t.Errorf("rcond: want in (0, 1], got %.4g", diag.RCond)
+	}
+}
+
+func TestSolveDiagnostics(t *testing.T) {
+	a := mat.NewRows([][]float64{
+		{4, 0, 0},
+		{0, -2, 0},
+		{0, 0, 0.5},
+	})
+	b := []float64{4, 2, 1}
+
+	got, diag, err := SolveDiagnostics(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, []float64{1, -1, 2}, got)
+	testDiagnostics(t, a, b, got, diag)
+	if want := 0.5 / 4; math.Abs(diag.RCond-want) > 1e-12 {
+		t.Errorf("rcond: want %.6g, got %.6g", want, diag.RCond)
+	}
+}
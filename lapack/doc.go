@@ -4,18 +4,122 @@ Package lapack links to native library for real linear algebra.
 Uses the following routines to solve linear systems:
 	SolveEps         dgelsd    SVD      general matrix (threshold singular values)
 	SolveFullRank    dgels     QR/LQ    full-rank matrix (min norm or min residual)
+	SolveQRPiv       dgelsy    QRPiv    general matrix (threshold condition of R)
+	SolveRidge       dgels     QR       Tikhonov-regularized least squares
+	SolveLSE         dgglse    GRQ      least squares with equality constraints
+	SolveGLM         dggglm    GQR      general Gauss-Markov linear model
 	SolveSquare      dgesv     LU       full-rank, square matrix
 	SolveSymm        dsysv     LDL      full-rank, square, symmetric matrix
 	SolvePosDef      dposv     Chol     full-rank, square, symmetric, positive-definite matrix
+	SolveTridiag     dgtsv     LU       full-rank, tridiagonal matrix
+	SolvePosDefTridiag dptsv   LDL      full-rank, symmetric, positive-definite, tridiagonal matrix
 and provides access to the following routines for computing and using decompositions:
 	LU      dgetrf dgetrs
 	QR      dgeqrf dormqr dtrtrs
+	QRPiv   dgeqp3 dormqr dtrtrs
+	LQ      dgelqf dormlq dtrtrs
 	Chol    dpotrf dpotrs
+	CholPiv dpstrf dpotrs
 	LDL     dsytrf dsytrs
 	SVD     dgesdd
-	Eig     dsyev
+	GSVD    dggsvd3
+	Eig     dsyev dstev
 
-No support for banded or triangular matrices.
-No support for packed representations.
+Nonsymmetric matrices can be balanced (Balance, dgebal dgebak)
+and EigExpert (dgeevx) computes their eigenvalues and eigenvectors
+with reciprocal condition numbers.
+The eigenvectors are returned in the real format of LAPACK (see EigExpertFact.Vector).
+
+The expert drivers additionally equilibrate the system, refine the solution
+and estimate its condition number and error bounds:
+	SolveSquareExpert    dgesvx
+	SolveSymmExpert      dsysvx
+	SolvePosDefExpert    dposvx
+
+The mixed-precision drivers factorize in single precision and refine in double precision,
+solving again in double precision if refinement fails:
+	SolveSquareMixed     dsgesv
+	SolvePosDefMixed     dsposv
+
+SolveAuto inspects the structure of the matrix
+and chooses between dtrtrs, dgtsv, dposv, dsysv, dgesv and dgelsd.
+It falls back to dgelsd if the reciprocal condition number
+estimated from the factorization is less than DefaultEps.
+
+The variants with the suffix Diagnostics of SolveSquare, SolvePosDef,
+SolveFullRank, Solve and SolveEps also return the residual, the relative residual,
+the normwise backward error and an estimate of the reciprocal condition number
+(dgecon, dpocon, dtrcon or the singular values from dgelsd).
+
+Cholesky and QR factorizations can be modified in O(n^2) time
+when A changes by a rank-one matrix, without factorizing again.
+CholFact provides Update and Downdate.
+QRFullFact (see QRFull) holds Q explicitly and provides Update,
+InsertCol, DeleteCol, InsertRow and DeleteRow.
+
+Polar computes the polar decomposition from the SVD (dgesdd).
+Procrustes and Kabsch use it to find the orthogonal transform,
+with optional scale and translation, which best aligns two sets of points.
+
+NewRidge takes a single SVD (dgesdd) to evaluate the regularized solution
+for a path of lambdas and helps to choose lambda by GCV or the L-curve.
+
+Failures reported by LAPACK are returned as *laerr.Error,
+which records the routine, info code and index
+and can be tested with errors.Is against the sentinel errors in package laerr
+(e.g. laerr.ErrSingular, laerr.ErrNotPosDef).
+
+Matrices and vectors are checked for NaN and Inf before calling LAPACK.
+The results which are checked afterwards are
+the solutions of linear systems (including ExpertSolution.X),
+the inverses from InvertPosDef and TriInvert,
+the products from TriMul and TriMulVec,
+the samples from CholPivFact.Sample
+and the vectors from BalanceFact.BackTransform.
+Factorizations, eigenvalues and singular values are not checked.
+Only the elements which LAPACK reads are checked:
+one triangle of a symmetric or triangular matrix
+and the three diagonals of a Tridiag.
+An element which is not finite is reported as *laerr.NonFiniteError
+(errors.Is(err, laerr.ErrNonFinite)).
+Opts.NoFiniteCheck skips the check in one call.
+
+The package-level defaults (DefaultTri, DefaultEps and the tolerances
+of the symmetry check) can be overridden per call with Opts
+using the variants with the suffix Opts of
+Chol, LDL, EigSymm, SolveSymm, SolvePosDef, InvertPosDef, Solve,
+SolveSquare, TriSolve, SolveAuto, the Diagnostics and InPlace variants,
+the methods of Workspace, the symmetric expert drivers and SolvePosDefMixed.
+The other functions always check for NaN and Inf.
+
+Most solvers and decompositions have a variant with the suffix InPlace
+(e.g. SolveSquareInPlace, CholInPlace) which takes ownership of a *Mat
+and overwrites it and the right-hand side instead of copying them.
+
+A Workspace caches workspace queries and memory between calls
+to avoid allocating in loops over problems of the same size.
+It provides SolveFullRank, Solve, SolveEps, SolveSquare, SolveSymm, SolvePosDef,
+LDL, QRSolve, SVD and EigSymm and their variants with the suffix Opts,
+which do not allocate on repeated calls.
+
+A Symmetric matrix is a view of one triangle of a full n x n matrix
+and is not checked for symmetry by the functions which require it.
+
+Tridiagonal matrices are stored as their three diagonals (see Tridiag).
+
+Symmetric matrices can be stored in packed format (see SymmPacked)
+using one triangle of n(n+1)/2 elements:
+	SolveSymmPacked     dspsv
+	SolvePosDefPacked   dppsv
+	CholPacked          dpptrf dpptrs
+	EigSymmPacked       dspev
+
+Triangular matrices (see Triangular, a view of one triangle
+of a full n x n matrix) can be used directly:
+	TriSolve    dtrtrs
+	TriInvert   dtrtri
+	TriMul      dtrmm dtrmv
+
+No support for general banded matrices.
 */
 package lapack
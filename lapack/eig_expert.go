@@ -0,0 +1,99 @@
+package lapack
+
+// EigExpertFact describes the eigenvalues and eigenvectors of a square matrix
+// with estimates of their sensitivity.
+type EigExpertFact struct {
+	Values []complex128
+	// Left and right eigenvectors, normalized to unit norm.
+	// If Values[j] is real, column j is its eigenvector.
+	// If Values[j] and Values[j+1] are a complex conjugate pair,
+	// columns j and j+1 hold the real and imaginary parts of the eigenvector of Values[j]
+	// (see Vector).
+	VL, VR *Mat
+	// Reciprocal condition numbers of each eigenvalue and right eigenvector.
+	// The error in Values[j] is about eps * ABNorm / RCondE[j]
+	// and the angular error in its eigenvector is about eps * ABNorm / RCondV[j].
+	RCondE, RCondV []float64
+	// Balancing which was applied (see BalanceFact).
+	ILo, IHi int
+	Scale    []float64
+	// One-norm of the balanced matrix.
+	ABNorm float64
+}
+
+// EigExpert computes the eigenvalues and left and right eigenvectors
+// of a square matrix after balancing it,
+// as well as the reciprocal condition numbers of the eigenvalues and right eigenvectors.
+// Calls DGEEVX.
+func EigExpert(a Const, job BalanceJob) (*EigExpertFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errBadBalance(job); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return eigExpert(cloneMat(a), job)
+}
+
+// a will be modified.
+func eigExpert(a *Mat, job BalanceJob) (*EigExpertFact, error) {
+	n, _ := a.Dims()
+	vl, vr := NewMat(n, n), NewMat(n, n)
+	r, err := dgeevx(job, n, a.Elems, n, vl.Elems, n, vr.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	w := make([]complex128, n)
+	for i := range w {
+		w[i] = complex(r.wr[i], r.wi[i])
+	}
+	return &EigExpertFact{
+		Values: w,
+		VL:     vl,
+		VR:     vr,
+		RCondE: r.rconde,
+		RCondV: r.rcondv,
+		ILo:    r.ilo - 1,
+		IHi:    r.ihi - 1,
+		Scale:  r.scale,
+		ABNorm: r.abnrm,
+	}, nil
+}
+
+// Vector returns the right eigenvector of Values[j].
+func (f *EigExpertFact) Vector(j int) []complex128 {
+	return unpackEigVec(f.VR, f.Values, j)
+}
+
+// LeftVector returns the left eigenvector of Values[j].
+func (f *EigExpertFact) LeftVector(j int) []complex128 {
+	return unpackEigVec(f.VL, f.Values, j)
+}
+
+// Returns column j of eigenvectors stored as real and imaginary parts.
+func unpackEigVec(v *Mat, w []complex128, j int) []complex128 {
+	n, _ := v.Dims()
+	x := make([]complex128, n)
+	switch {
+	case imag(w[j]) == 0:
+		for i := range x {
+			x[i] = complex(v.At(i, j), 0)
+		}
+	case imag(w[j]) > 0:
+		for i := range x {
+			x[i] = complex(v.At(i, j), v.At(i, j+1))
+		}
+	default:
+		// Conjugate of the eigenvector of the previous eigenvalue.
+		for i := range x {
+			x[i] = complex(v.At(i, j-1), -v.At(i, j))
+		}
+	}
+	return x
+}
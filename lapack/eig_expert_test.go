@@ -0,0 +1,92 @@
+package lapack
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Checks that A x = lambda x for a real matrix and complex vector
+// relative to the norms of A and x.
+func testEigVec(t *testing.T, a mat.Const, lambda complex128, x []complex128) {
+	n, _ := a.Dims()
+	var normX float64
+	for _, xi := range x {
+		normX = math.Max(normX, cmplx.Abs(xi))
+	}
+	tol := 1e-9 * mat.Norm(a, mat.OneNorm) * normX
+	for i := 0; i < n; i++ {
+		var ax complex128
+		for j := 0; j < n; j++ {
+			ax += complex(a.At(i, j), 0) * x[j]
+		}
+		if cmplx.Abs(ax-lambda*x[i]) > tol {
+			t.Errorf("eigenvalue %v: at %d: want %v, got %v", lambda, i, lambda*x[i], ax)
+		}
+	}
+}
+
+func TestEigExpert(t *testing.T) {
+	n := 20
+	a := randMat(n, n)
+	f, err := EigExpert(a, BalanceBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j := range f.Values {
+		testEigVec(t, a, f.Values[j], f.Vector(j))
+		if !(f.RCondE[j] > 0 && f.RCondE[j] <= 1+1e-9) {
+			t.Errorf("eigenvalue %d: reciprocal condition number %g not in (0, 1]", j, f.RCondE[j])
+		}
+	}
+	// Check that y' A = lambda y' for the left eigenvectors.
+	at := mat.T(a)
+	for j := range f.Values {
+		y := f.LeftVector(j)
+		for i := range y {
+			y[i] = cmplx.Conj(y[i])
+		}
+		testEigVec(t, at, f.Values[j], y)
+	}
+}
+
+func TestBalance(t *testing.T) {
+	// Badly scaled matrix.
+	a := mat.NewRows([][]float64{
+		{1, 1e6, 0},
+		{1e-6, 2, 1e6},
+		{0, 1e-6, 3},
+	})
+	bal, err := Balance(a, BalanceBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mat.Norm(bal.A, mat.OneNorm) >= mat.Norm(a, mat.OneNorm) {
+		t.Errorf("balancing did not reduce norm: %g", mat.Norm(bal.A, mat.OneNorm))
+	}
+	// Eigenvectors of the balanced matrix map to those of A.
+	f, err := EigExpert(bal.A, NoBalance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vr, err := bal.BackTransform(f.VR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &EigExpertFact{Values: f.Values, VR: vr}
+	for j := range f.Values {
+		testEigVec(t, a, f.Values[j], g.Vector(j))
+	}
+}
+
+func TestBalance_badJob(t *testing.T) {
+	a := randMat(3, 3)
+	if _, err := Balance(a, BalanceJob('X')); err == nil {
+		t.Error("Balance: expected error")
+	}
+	if _, err := EigExpert(a, BalanceJob('X')); err == nil {
+		t.Error("EigExpert: expected error")
+	}
+}
@@ -6,21 +6,32 @@ package lapack
 // There is no function to compute the eigenvectors of a general matrix
 // in this library because matrices with real eigenvectors are symmetric.
 func EigSymm(a Const) (*Mat, []float64, error) {
+	return EigSymmOpts(a, nil)
+}
+
+// EigSymmOpts is like EigSymm but takes options.
+func EigSymmOpts(a Const, opts *Opts) (*Mat, []float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
 		return nil, nil, err
 	}
-	return eigSymm(cloneMat(a), DefaultTri)
+	return eigSymm(cloneMat(a), opts.tri())
 }
 
 func eigSymm(a *Mat, tri Triangle) (*Mat, []float64, error) {
 	n, _ := a.Dims()
-	d, err := dsyev(vectors, UpperTri, n, a.Elems, n)
+	d, err := dsyev(nil, vectors, tri, n, a.Elems, n)
 	if err != nil {
 		return nil, nil, err
 	}
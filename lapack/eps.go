@@ -7,19 +7,34 @@ func Solve(a Const, b []float64) ([]float64, error) {
 	return SolveEps(a, b, DefaultEps)
 }
 
+// SolveOpts is like Solve but takes the tolerance from the options.
+func SolveOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	return solveEpsOpts(a, b, opts.eps(), opts)
+}
+
 // Solves A x = b.
 // The user must specify epsilon (inverse maximum condition number)
 // at which the line is drawn between equality constraints and residuals.
 // Calls DGELSD (eps is the "rcond" parameter).
 func SolveEps(a Const, b []float64, eps float64) ([]float64, error) {
+	return solveEpsOpts(a, b, eps, nil)
+}
+
+func solveEpsOpts(a Const, b []float64, eps float64, opts *Opts) ([]float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
 	m, n := a.Dims()
-	return solveEps(cloneMat(a), cloneSliceCap(b, max(m, n)), eps)
+	return opts.finiteResult(solveEps(cloneMat(a), cloneSliceCap(b, max(m, n)), eps))
 }
 
 // a and b will be modified.
@@ -27,7 +42,7 @@ func SolveEps(a Const, b []float64, eps float64) ([]float64, error) {
 func solveEps(a *Mat, b []float64, eps float64) ([]float64, error) {
 	m, n := a.Dims()
 	b = b[:max(m, n)]
-	err := dgelsd(m, n, 1, a.Elems, m, b, len(b), eps)
+	_, err := dgelsd(nil, m, n, 1, a.Elems, m, b, len(b), eps)
 	if err != nil {
 		return nil, err
 	}
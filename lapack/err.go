@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+
+	"github.com/jvlmdr/lin-go/laerr"
 )
 
-func errUnknown(info int) error {
-	return fmt.Errorf("lapack info non-zero: %d", info)
+func errUnknown(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrUnknown)
 }
 
 func errNonPosDims(a Const) error {
@@ -50,6 +52,25 @@ func errIncompatT(a Const, t bool, b []float64) error {
 	return nil
 }
 
+func errIncompatMatT(a Const, t bool, b Const) error {
+	rows, cols := a.Dims()
+	if t {
+		rows, cols = cols, rows
+	}
+	p, q := b.Dims()
+	if rows != p {
+		return fmt.Errorf("incompatible: %dx%d and %dx%d", rows, cols, p, q)
+	}
+	return nil
+}
+
+func errNotInTri(i, j int, tri Triangle, unit bool) error {
+	if i == j && unit {
+		return fmt.Errorf("cannot set unit diagonal: at %d, %d", i, j)
+	}
+	return fmt.Errorf("not in triangle %c: at %d, %d", rune(tri), i, j)
+}
+
 var (
 	EpsSymmAbs float64 = 1e-9
 	EpsSymmRel float64 = 1e-9
@@ -58,11 +79,19 @@ var (
 // Returns an error if the matrix is not symmetric.
 // Assumes that matrix is square.
 func errNonSymm(a Const) error {
+	return errNonSymmEps(a, EpsSymmAbs, EpsSymmRel)
+}
+
+func errNonSymmEps(a Const, epsAbs, epsRel float64) error {
+	// Symmetric by construction.
+	if _, ok := a.(*Symmetric); ok {
+		return nil
+	}
 	n, _ := a.Dims()
 	for i := 0; i < n; i++ {
 		for j := i; j < n; j++ {
 			ij, ji := a.At(i, j), a.At(j, i)
-			if !(eqEpsAbs(ij, ji, EpsSymmAbs) || eqEpsRel(ij, ji, EpsSymmRel)) {
+			if !(eqEpsAbs(ij, ji, epsAbs) || eqEpsRel(ij, ji, epsRel)) {
 				return fmt.Errorf("not symmetric: at %d, %d: upper %g, lower %g", i, j, ij, ji)
 			}
 		}
@@ -70,6 +99,45 @@ func errNonSymm(a Const) error {
 	return nil
 }
 
+// Returns an error if the number of elements of a packed matrix
+// does not match its dimension.
+func errBadPacked(a *SymmPacked) error {
+	if a.N == 0 {
+		return errors.New("matrix empty")
+	}
+	if a.N < 0 {
+		return fmt.Errorf("matrix dims not positive: %dx%d", a.N, a.N)
+	}
+	if len(a.Elems) != a.N*(a.N+1)/2 {
+		return fmt.Errorf("invalid packed matrix: %d elements for %dx%d", len(a.Elems), a.N, a.N)
+	}
+	return nil
+}
+
+// Returns an error if the diagonals of a tridiagonal matrix
+// have inconsistent lengths.
+func errBadTridiag(a *Tridiag) error {
+	n := len(a.Diag)
+	if n == 0 {
+		return errors.New("matrix empty")
+	}
+	if len(a.Sub) != n-1 || len(a.Super) != n-1 {
+		return fmt.Errorf("invalid tridiagonal: sub %d, diag %d, super %d", len(a.Sub), n, len(a.Super))
+	}
+	return nil
+}
+
+// Returns an error if the tridiagonal matrix is not symmetric.
+func errNonSymmTridiag(a *Tridiag) error {
+	for i := range a.Sub {
+		lower, upper := a.Sub[i], a.Super[i]
+		if !(eqEpsAbs(upper, lower, EpsSymmAbs) || eqEpsRel(upper, lower, EpsSymmRel)) {
+			return fmt.Errorf("not symmetric: at %d, %d: upper %g, lower %g", i, i+1, upper, lower)
+		}
+	}
+	return nil
+}
+
 func eqEpsAbs(a, b, eps float64) bool {
 	if a == b {
 		return true
@@ -85,30 +153,225 @@ func eqEpsRel(a, b, eps float64) bool {
 	return math.Abs(a-b) <= eps*math.Max(math.Abs(a), math.Abs(b))
 }
 
-func errInvalidArg(arg int) error {
-	return fmt.Errorf("invalid arg: %d", arg)
+// arg is the one-based argument number (-info).
+func errInvalidArg(routine string, arg int) error {
+	return laerr.New(routine, -arg, arg-1, laerr.ErrInvalidArg)
 }
 
-func errSingular(index int) error {
-	return fmt.Errorf("exactly singular: at index %d", index)
+// index is one-based (info).
+func errSingular(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrSingular)
 }
 
-func errNotPosDef(index int) error {
-	return fmt.Errorf("matrix not pos def: at index %d", index)
+// index is one-based (info).
+func errNotPosDef(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrNotPosDef)
 }
 
-func errOffDiagFailConverge(index int) error {
-	return fmt.Errorf("off-diag elements failed to converge to zero: %d", index)
+// info is the number of off-diagonal elements which did not converge to zero.
+func errOffDiagFailConverge(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrFailConverge)
 }
 
 func errBadShape(m, n int) error {
 	return fmt.Errorf("invalid shape: %dx%d", m, n)
 }
 
-func errNotFullRank(index int) error {
-	return fmt.Errorf("not full rank: at index %d", index)
+// index is one-based (info).
+func errNotFullRank(routine string, index int) error {
+	return laerr.New(routine, index, index-1, laerr.ErrNotFullRank)
+}
+
+func errFailConverge(routine string, info int) error {
+	return laerr.New(routine, info, -1, laerr.ErrFailConverge)
+}
+
+// NearSingularError is returned alongside the solution by the expert drivers
+// when the matrix is singular to working precision.
+type NearSingularError = laerr.NearSingularError
+
+func errNearSingular(routine string, rcond float64) error {
+	return &NearSingularError{Routine: routine, RCond: rcond}
+}
+
+func errIncompatCols(a, b Const) error {
+	m, n := a.Dims()
+	p, q := b.Dims()
+	if n != q {
+		return fmt.Errorf("different number of columns: %dx%d and %dx%d", m, n, p, q)
+	}
+	return nil
+}
+
+func errDimsNotEq(a, b Const) error {
+	m, n := a.Dims()
+	p, q := b.Dims()
+	if m != p || n != q {
+		return fmt.Errorf("different dims: %dx%d and %dx%d", m, n, p, q)
+	}
+	return nil
+}
+
+func errBadShapeLSE(m, n, p int) error {
+	return fmt.Errorf("invalid shape: A %dx%d, B %dx%d: need p <= n <= m+p", m, n, p, n)
+}
+
+func errBadShapeGLM(n, m, p int) error {
+	return fmt.Errorf("invalid shape: A %dx%d, B %dx%d: need m <= n <= m+p", n, m, n, p)
+}
+
+func errNotFullRankMat(routine string, info int, name string) error {
+	err := laerr.New(routine, info, -1, laerr.ErrNotFullRank)
+	err.Arg = name
+	return err
+}
+
+func errNegLambda(lambda float64) error {
+	if !(lambda >= 0) {
+		return fmt.Errorf("regularization not non-negative: %g", lambda)
+	}
+	return nil
 }
 
-func errFailConverge(info int) error {
-	return fmt.Errorf("did not converge: info %d", info)
+func errIndex(i, n int) error {
+	return fmt.Errorf("index out of range: %d not in [0, %d)", i, n)
+}
+
+func errIncompatRank(rank, n int) error {
+	return fmt.Errorf("incompatible: rank %d and %d", rank, n)
+}
+
+func errBadMat(a *Mat) error {
+	if len(a.Elems) != a.Rows*a.Cols {
+		return fmt.Errorf("matrix %dx%d has %d elements", a.Rows, a.Cols, len(a.Elems))
+	}
+	return nil
+}
+
+func errCap(b []float64, n int) error {
+	if cap(b) < n {
+		return fmt.Errorf("insufficient capacity: need %d, have %d", n, cap(b))
+	}
+	return nil
+}
+
+func errBadTri(tri Triangle) error {
+	return fmt.Errorf("invalid triangle: %q", rune(tri))
+}
+
+// Returns an error if the balancing job is not valid.
+func errBadBalance(job BalanceJob) error {
+	switch job {
+	case NoBalance, BalancePermute, BalanceScale, BalanceBoth:
+		return nil
+	default:
+		return fmt.Errorf("invalid balance job: %q", rune(job))
+	}
+}
+
+func isFinite(v float64) bool {
+	return !(math.IsNaN(v) || math.IsInf(v, 0))
+}
+
+// Returns an error if an element of the matrix is not finite.
+func errNonFinite(a Const) error {
+	m, n := a.Dims()
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			if err := errNonFiniteAt("matrix", a, i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns an error if an element of a square matrix
+// in the given triangle is not finite.
+// The other triangle is not accessed,
+// nor is the diagonal if unit is true.
+func errNonFiniteTri(a Const, tri Triangle, unit bool) error {
+	n, _ := a.Dims()
+	for j := 0; j < n; j++ {
+		// Rows i0 <= i < i1 of column j are in the triangle.
+		i0, i1 := 0, j+1
+		if tri == LowerTri {
+			i0, i1 = j, n
+		}
+		for i := i0; i < i1; i++ {
+			if i == j && unit {
+				continue
+			}
+			if err := errNonFiniteAt("matrix", a, i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// name is "matrix" for an argument or "result" for a solution.
+func errNonFiniteAt(name string, a Const, i, j int) error {
+	if v := a.At(i, j); !isFinite(v) {
+		return &laerr.NonFiniteError{Arg: name, Row: i, Col: j, Value: complex(v, 0)}
+	}
+	return nil
+}
+
+// Returns an error if an element of the vector is not finite.
+// name is "vector" for an argument or "result" for a solution.
+func errNonFiniteVec(name string, x []float64) error {
+	for i, v := range x {
+		if !isFinite(v) {
+			return &laerr.NonFiniteError{Arg: name, Row: i, Col: -1, Value: complex(v, 0)}
+		}
+	}
+	return nil
+}
+
+// Returns an error if an element of the three diagonals is not finite.
+// Does not visit the zeros outside the band.
+func errNonFiniteTridiag(a *Tridiag) error {
+	for i := range a.Diag {
+		if err := errNonFiniteAt("matrix", a, i, i); err != nil {
+			return err
+		}
+		if i+1 < len(a.Diag) {
+			if err := errNonFiniteAt("matrix", a, i+1, i); err != nil {
+				return err
+			}
+			if err := errNonFiniteAt("matrix", a, i, i+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the result of a solver,
+// or an error if it failed or an element of x is not finite.
+// Used as finiteResult(solve(...)).
+func finiteResult(x []float64, err error) ([]float64, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("result", x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Like finiteResult for a matrix.
+func finiteResultMat(x *Mat, err error) (*Mat, error) {
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < x.Cols; j++ {
+		for i := 0; i < x.Rows; i++ {
+			if err := errNonFiniteAt("result", x, i, j); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return x, nil
 }
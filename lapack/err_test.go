@@ -0,0 +1,183 @@
+package lapack
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/laerr"
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveSquare_nonFinite(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	a.Set(2, 1, math.NaN())
+	b := randVec(n)
+
+	_, err := SolveSquare(a, b)
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.NonFiniteError, got %v", err)
+	}
+	if e.Arg != "matrix" || e.Row != 2 || e.Col != 1 {
+		t.Errorf("want matrix at 2, 1: got %s at %d, %d", e.Arg, e.Row, e.Col)
+	}
+}
+
+func TestSolveSquare_nonFiniteVec(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	b := randVec(n)
+	b[3] = math.Inf(-1)
+
+	_, err := SolveSquare(a, b)
+	if !errors.Is(err, laerr.ErrNonFinite) {
+		t.Fatalf("expected laerr.ErrNonFinite, got %v", err)
+	}
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) || e.Arg != "vector" || e.Row != 3 || e.Col != -1 {
+		t.Errorf("want vector at 3: got %v", err)
+	}
+}
+
+func TestSolveSquare_nonFiniteResult(t *testing.T) {
+	// Solution overflows although A is not singular.
+	a := mat.NewRows([][]float64{
+		{1e-200, 0},
+		{0, 1},
+	})
+	b := []float64{1e200, 1}
+
+	_, err := SolveSquare(a, b)
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.NonFiniteError, got %v", err)
+	}
+	if e.Arg != "result" || e.Row != 0 {
+		t.Errorf("want result at 0: got %v", err)
+	}
+}
+
+func TestSolveTridiag_nonFinite(t *testing.T) {
+	a := randTridiag(5)
+	a.Super[2] = math.NaN()
+	b := randVec(5)
+
+	_, err := SolveTridiag(a, b)
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.NonFiniteError, got %v", err)
+	}
+	if e.Row != 2 || e.Col != 3 {
+		t.Errorf("want at 2, 3: got %d, %d", e.Row, e.Col)
+	}
+}
+
+func TestOpts_noFiniteCheck(t *testing.T) {
+	opts := &Opts{NoFiniteCheck: true}
+	a := randMat(3, 3)
+	a.Set(0, 0, math.Inf(1))
+	if err := opts.errNonFinite(a); err != nil {
+		t.Errorf("expected no error when disabled, got %v", err)
+	}
+	if err := opts.errNonFiniteVec([]float64{math.NaN()}); err != nil {
+		t.Errorf("expected no error when disabled, got %v", err)
+	}
+	if err := errNonFinite(a); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected laerr.ErrNonFinite without options, got %v", err)
+	}
+}
+
+func TestTriSolveOpts_noFiniteCheck(t *testing.T) {
+	n := 4
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			a.Set(i, j, 1)
+		}
+	}
+	b := randVec(n)
+	b[0] = math.NaN()
+
+	if _, err := TriSolve(LowerTri, false, false, a, b); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Fatalf("expected laerr.ErrNonFinite, got %v", err)
+	}
+	x, err := TriSolveOpts(LowerTri, false, false, a, b, &Opts{NoFiniteCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(x[n-1]) {
+		t.Errorf("expected NaN to propagate, got %v", x[n-1])
+	}
+}
+
+func TestTriSolve_nonFiniteOtherTri(t *testing.T) {
+	n := 4
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			a.Set(i, j, 1)
+		}
+		a.Set(i, i, float64(n))
+	}
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+	// Elements outside the triangle are not read.
+	a.Set(0, n-1, math.NaN())
+
+	got, err := TriSolve(LowerTri, false, false, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveSymmOpts_nonFiniteOtherTri(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+	// Elements outside the triangle are not read.
+	a.Set(0, n-1, math.Inf(1))
+
+	got, err := SolveSymmOpts(a, b, &Opts{Tri: LowerTri, NoSymmCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveSymmOpts_noFiniteCheck(t *testing.T) {
+	n := 4
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	b := randVec(n)
+	b[1] = math.NaN()
+
+	if _, err := SolveSymmOpts(a, b, nil); !errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected laerr.ErrNonFinite, got %v", err)
+	}
+	if _, err := SolveSymmOpts(a, b, &Opts{NoFiniteCheck: true}); errors.Is(err, laerr.ErrNonFinite) {
+		t.Errorf("expected no check when disabled, got %v", err)
+	}
+}
+
+func TestTriMulVec_nonFiniteResult(t *testing.T) {
+	n := 3
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, 1e200)
+	}
+	x := []float64{1, 1e200, 1}
+
+	_, err := TriMulVec(UpperTri, false, false, a, x)
+	var e *laerr.NonFiniteError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.NonFiniteError, got %v", err)
+	}
+	if e.Arg != "result" || e.Row != 1 {
+		t.Errorf("expected element 1 of result, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package lapack
+
+// ExpertSolution is the solution of a linear system
+// computed by an expert driver, with estimates of its accuracy.
+type ExpertSolution struct {
+	// Solution, one column per right-hand side.
+	X *Mat
+	// Reciprocal condition number of the (equilibrated) matrix.
+	RCond float64
+	// Estimated forward error bound for each column of X.
+	FErr []float64
+	// Componentwise relative backward error for each column of X.
+	BErr []float64
+	// Whether the system was scaled before being solved.
+	Equilibrated bool
+}
+
+// SolveSquareExpert finds X such that A X = B where A is square.
+// The system is equilibrated if necessary
+// and the solution is improved by iterative refinement.
+// Calls DGESVX.
+//
+// If A is singular to working precision,
+// the solution is returned with an error of type *NearSingularError.
+func SolveSquareExpert(a, b Const) (*ExpertSolution, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(a, false, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	return finiteExpertResult(solveSquareExpert(cloneMat(a), cloneMat(b)))
+}
+
+// a and b will be modified.
+func solveSquareExpert(a, b *Mat) (*ExpertSolution, error) {
+	n, nrhs := b.Dims()
+	sol := newExpertSolution(n, nrhs)
+	var err error
+	sol.RCond, sol.Equilibrated, err = dgesvx(false, n, nrhs, a.Elems, n, b.Elems, n, sol.X.Elems, n, sol.FErr, sol.BErr)
+	return expertResult(sol, err)
+}
+
+// SolvePosDefExpert finds X such that A X = B
+// where A is symmetric and positive-definite.
+// The system is equilibrated if necessary
+// and the solution is improved by iterative refinement.
+// Calls DPOSVX.
+//
+// If A is singular to working precision,
+// the solution is returned with an error of type *NearSingularError.
+func SolvePosDefExpert(a, b Const) (*ExpertSolution, error) {
+	return SolvePosDefExpertOpts(a, b, nil)
+}
+
+// SolvePosDefExpertOpts is like SolvePosDefExpert but takes options.
+func SolvePosDefExpertOpts(a, b Const, opts *Opts) (*ExpertSolution, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(a, false, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return opts.finiteExpertResult(solvePosDefExpert(cloneMat(a), cloneMat(b), opts.tri()))
+}
+
+// a and b will be modified.
+func solvePosDefExpert(a, b *Mat, tri Triangle) (*ExpertSolution, error) {
+	n, nrhs := b.Dims()
+	sol := newExpertSolution(n, nrhs)
+	var err error
+	sol.RCond, sol.Equilibrated, err = dposvx(tri, n, nrhs, a.Elems, n, b.Elems, n, sol.X.Elems, n, sol.FErr, sol.BErr)
+	return expertResult(sol, err)
+}
+
+// SolveSymmExpert finds X such that A X = B where A is symmetric.
+// The solution is improved by iterative refinement.
+// The system is not equilibrated.
+// Calls DSYSVX.
+//
+// If A is singular to working precision,
+// the solution is returned with an error of type *NearSingularError.
+func SolveSymmExpert(a, b Const) (*ExpertSolution, error) {
+	return SolveSymmExpertOpts(a, b, nil)
+}
+
+// SolveSymmExpertOpts is like SolveSymmExpert but takes options.
+func SolveSymmExpertOpts(a, b Const, opts *Opts) (*ExpertSolution, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(a, false, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return opts.finiteExpertResult(solveSymmExpert(cloneMat(a), cloneMat(b), opts.tri()))
+}
+
+// a and b will be modified.
+func solveSymmExpert(a, b *Mat, tri Triangle) (*ExpertSolution, error) {
+	n, nrhs := b.Dims()
+	sol := newExpertSolution(n, nrhs)
+	var err error
+	sol.RCond, err = dsysvx(tri, n, nrhs, a.Elems, n, b.Elems, n, sol.X.Elems, n, sol.FErr, sol.BErr)
+	return expertResult(sol, err)
+}
+
+func newExpertSolution(n, nrhs int) *ExpertSolution {
+	return &ExpertSolution{
+		X:    NewMat(n, nrhs),
+		FErr: make([]float64, nrhs),
+		BErr: make([]float64, nrhs),
+	}
+}
+
+// Returns the solution with a warning
+// if the matrix is singular to working precision,
+// or only the error if the solve failed.
+func expertResult(sol *ExpertSolution, err error) (*ExpertSolution, error) {
+	if err != nil {
+		if _, ok := err.(*NearSingularError); ok {
+			return sol, err
+		}
+		return nil, err
+	}
+	return sol, nil
+}
+
+// Checks the solution for NaN and Inf.
+// The solution is still checked if it is returned with a NearSingularError.
+func finiteExpertResult(sol *ExpertSolution, err error) (*ExpertSolution, error) {
+	if sol == nil {
+		return nil, err
+	}
+	if _, err := finiteResultMat(sol.X, nil); err != nil {
+		return nil, err
+	}
+	return sol, err
+}
@@ -0,0 +1,106 @@
+package lapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveSquareExpert(t *testing.T) {
+	n, k := 100, 3
+	a := randMat(n, n)
+	want := randMat(n, k)
+	b := mat.Mul(a, want)
+
+	sol, err := SolveSquareExpert(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, want, sol.X)
+	testExpertSolution(t, sol, k)
+}
+
+func TestSolvePosDefExpert(t *testing.T) {
+	n, k := 100, 3
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randMat(n, k)
+	b := mat.Mul(a, want)
+
+	sol, err := SolvePosDefExpert(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, want, sol.X)
+	testExpertSolution(t, sol, k)
+}
+
+func TestSolveSymmExpert(t *testing.T) {
+	n, k := 100, 3
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randMat(n, k)
+	b := mat.Mul(a, want)
+
+	sol, err := SolveSymmExpert(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, want, sol.X)
+	testExpertSolution(t, sol, k)
+}
+
+func TestSolveSquareExpert_nearSingular(t *testing.T) {
+	// Smallest perturbation from an exactly singular matrix.
+	a := mat.NewRows([][]float64{
+		{1, 1},
+		{1, math.Nextafter(1, 2)},
+	})
+	b := mat.NewCols([][]float64{{2, 2}})
+
+	sol, err := SolveSquareExpert(a, b)
+	if err == nil {
+		t.Fatal("expected warning for near-singular matrix")
+	}
+	if _, ok := err.(*NearSingularError); !ok {
+		t.Fatalf("expected *NearSingularError, got %T: %v", err, err)
+	}
+	if sol == nil {
+		t.Fatal("expected solution with warning")
+	}
+}
+
+func testExpertSolution(t *testing.T, sol *ExpertSolution, k int) {
+	if !(sol.RCond > 0 && sol.RCond <= 1) {
+		t.Errorf("invalid rcond: %g", sol.RCond)
+	}
+	if len(sol.FErr) != k || len(sol.BErr) != k {
+		t.Fatalf("want %d error bounds, got %d and %d", k, len(sol.FErr), len(sol.BErr))
+	}
+	for j := 0; j < k; j++ {
+		if sol.BErr[j] > eps {
+			t.Errorf("backward error too large: column %d: %g", j, sol.BErr[j])
+		}
+	}
+}
+
+func ExampleSolveSquareExpert() {
+	a := mat.NewRows([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+	// x = [1; 2]
+	// b = A x = [5; 11]
+	b := mat.NewCols([][]float64{{5, 11}})
+
+	sol, err := SolveSquareExpert(a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", mat.Col(sol.X, 0))
+	// Output:
+	// [1 2]
+}
@@ -11,8 +11,14 @@ func SolveFullRank(a Const, b []float64) ([]float64, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
 	m, n := a.Dims()
-	return solveFullRank(cloneMat(a), cloneSliceCap(b, max(m, n)))
+	return finiteResult(solveFullRank(cloneMat(a), cloneSliceCap(b, max(m, n))))
 }
 
 // a and b will be modified.
@@ -20,7 +26,7 @@ func SolveFullRank(a Const, b []float64) ([]float64, error) {
 func solveFullRank(a *Mat, b []float64) ([]float64, error) {
 	m, n := a.Dims()
 	b = b[:max(m, n)]
-	err := dgels(m, n, 1, a.Elems, m, b, len(b))
+	err := dgels(nil, m, n, 1, a.Elems, m, b, len(b))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,111 @@
+package lapack
+
+// SolveLSE finds x which minimizes ||A x - c|| subject to B x = d.
+// A is m x n and B is p x n with p <= n <= m+p.
+// B must have full row rank and [A; B] must have full column rank.
+// Calls DGGLSE.
+func SolveLSE(a Const, c []float64, b Const, d []float64) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(b); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, c); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(b, d); err != nil {
+		return nil, err
+	}
+	if err := errIncompatCols(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", c); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", d); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	p, _ := b.Dims()
+	if !(p <= n && n <= m+p) {
+		return nil, errBadShapeLSE(m, n, p)
+	}
+	return finiteResult(solveLSE(cloneMat(a), cloneSlice(c), cloneMat(b), cloneSlice(d)))
+}
+
+// a, c, b and d will be modified.
+func solveLSE(a *Mat, c []float64, b *Mat, d []float64) ([]float64, error) {
+	m, n := a.Dims()
+	p, _ := b.Dims()
+	x := make([]float64, n)
+	err := dgglse(m, n, p, a.Elems, m, b.Elems, p, c, d, x)
+	if err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// SolveGLM solves the general Gauss-Markov linear model problem,
+// finding x and y which minimize ||y|| subject to d = A x + B y.
+// A is n x m and B is n x p with m <= n <= m+p.
+// A must have full column rank and [A, B] must have full row rank.
+// Calls DGGGLM.
+//
+// If B is square and invertible, x minimizes ||B^-1 (d - A x)||,
+// the weighted least-squares problem with noise covariance B B'.
+func SolveGLM(a, b Const, d []float64) (x, y []float64, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonPosDims(b); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(a, d); err != nil {
+		return nil, nil, err
+	}
+	if err := errIncompat(b, d); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFiniteVec("vector", d); err != nil {
+		return nil, nil, err
+	}
+	n, m := a.Dims()
+	_, p := b.Dims()
+	if !(m <= n && n <= m+p) {
+		return nil, nil, errBadShapeGLM(n, m, p)
+	}
+	x, y, err = solveGLM(cloneMat(a), cloneMat(b), cloneSlice(d))
+	if x, err = finiteResult(x, err); err != nil {
+		return nil, nil, err
+	}
+	if y, err = finiteResult(y, nil); err != nil {
+		return nil, nil, err
+	}
+	return x, y, nil
+}
+
+// a, b and d will be modified.
+func solveGLM(a, b *Mat, d []float64) (x, y []float64, err error) {
+	n, m := a.Dims()
+	_, p := b.Dims()
+	x = make([]float64, m)
+	y = make([]float64, p)
+	err = dggglm(n, m, p, a.Elems, n, b.Elems, n, d, x, y)
+	if err != nil {
+		return nil, nil, err
+	}
+	return x, y, nil
+}
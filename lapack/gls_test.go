@@ -0,0 +1,102 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveLSE(t *testing.T) {
+	m, n, p := 100, 40, 10
+	a := randMat(m, n)
+	c := randVec(m)
+	b := randMat(p, n)
+	d := randVec(p)
+
+	// Solve the KKT system
+	//   [A' A, B'; B, 0] [x; z] = [A' c; d].
+	k := mat.Stack(
+		mat.Augment(mat.Mul(mat.T(a), a), mat.T(b)),
+		mat.Augment(b, mat.New(p, p)),
+	)
+	kkt, err := SolveSymm(k, append(mat.MulVec(mat.T(a), c), d...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := kkt[:n]
+
+	got, err := SolveLSE(a, c, b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+	// Check that constraints are satisfied.
+	testSliceEq(t, d, mat.MulVec(b, got))
+}
+
+func TestSolveLSE_badShape(t *testing.T) {
+	// More constraints than variables.
+	a := randMat(10, 4)
+	b := randMat(5, 4)
+	if _, err := SolveLSE(a, randVec(10), b, randVec(5)); err == nil {
+		t.Fatal("expected error for p > n")
+	}
+}
+
+func TestSolveGLM(t *testing.T) {
+	n, m := 100, 40
+	a := randMat(n, m)
+	b := randMat(n, n)
+	d := randVec(n)
+
+	// Weighted least squares: minimize ||B^-1 (d - A x)||.
+	// Z <- B \ A
+	z := mat.New(n, m)
+	for j := 0; j < m; j++ {
+		zj, err := SolveSquare(b, mat.Col(a, j))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mat.SetCol(z, j, zj)
+	}
+	// e <- B \ d
+	e, err := SolveSquare(b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := SolveFullRank(z, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, y, err := SolveGLM(a, b, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, x)
+	// Check that d = A x + B y.
+	got := mat.MulVec(a, x)
+	by := mat.MulVec(b, y)
+	for i := range got {
+		got[i] += by[i]
+	}
+	testSliceEq(t, d, got)
+}
+
+func ExampleSolveLSE() {
+	// Find x closest to [2; 3; 4] such that its elements sum to 6.
+	a := mat.I(3)
+	c := []float64{2, 3, 4}
+	b := mat.NewRows([][]float64{{1, 1, 1}})
+	d := []float64{6}
+
+	x, err := SolveLSE(a, c, b, d)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", x)
+	// Output:
+	// [1 2 3]
+}
@@ -0,0 +1,99 @@
+package lapack
+
+// GSVDFact describes a generalized singular value decomposition
+// of an m x n matrix A and a p x n matrix B
+//	U' A Q = D1 [0, R],
+//	V' B Q = D2 [0, R],
+// where U, V and Q are orthogonal, R is (k+l) x (k+l) upper triangular
+// and D1 and D2 are m x (k+l) and p x (k+l) diagonal matrices
+// whose squared diagonals sum to one.
+// The generalized singular values are Alpha[i] / Beta[i].
+type GSVDFact struct {
+	U, V, Q *Mat
+	// Diagonals of D1 and D2, with k+l elements each.
+	Alpha, Beta []float64
+	// The first K pairs are (1, 0).
+	// K+L is the effective rank of [A; B].
+	K, L int
+	R    *Mat
+}
+
+// GSVD computes the generalized singular value decomposition of A and B,
+// which must have the same number of columns.
+// Calls DGGSVD3.
+func GSVD(a, b Const) (*GSVDFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(b); err != nil {
+		return nil, err
+	}
+	if err := errIncompatCols(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	return gsvd(cloneMat(a), cloneMat(b))
+}
+
+// a and b will be modified.
+func gsvd(a, b *Mat) (*GSVDFact, error) {
+	m, n := a.Dims()
+	p, _ := b.Dims()
+	u, v, q := NewMat(m, m), NewMat(p, p), NewMat(n, n)
+	alpha, beta := make([]float64, n), make([]float64, n)
+	k, l, err := dggsvd3(m, n, p, a.Elems, m, b.Elems, p, alpha, beta, u.Elems, m, v.Elems, p, q.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// R is stored in A(0:k+l, n-k-l:n) if m >= k+l,
+	// otherwise its last k+l-m rows are stored in B(m-k:l, n+m-k-l:n).
+	r := NewMat(k+l, k+l)
+	for i := 0; i < k+l; i++ {
+		for j := i; j < k+l; j++ {
+			if i < m {
+				r.Set(i, j, a.At(i, n-k-l+j))
+			} else {
+				r.Set(i, j, b.At(i-k, n-k-l+j))
+			}
+		}
+	}
+	return &GSVDFact{u, v, q, alpha[:k+l], beta[:k+l], k, l, r}, nil
+}
+
+// D1 returns the m x (k+l) diagonal matrix such that U' A Q = D1 [0, R].
+func (f *GSVDFact) D1() *Mat {
+	m, _ := f.U.Dims()
+	d := NewMat(m, f.K+f.L)
+	for i := 0; i < min(m, f.K+f.L); i++ {
+		d.Set(i, i, f.Alpha[i])
+	}
+	return d
+}
+
+// D2 returns the p x (k+l) diagonal matrix such that V' B Q = D2 [0, R].
+func (f *GSVDFact) D2() *Mat {
+	p, _ := f.V.Dims()
+	d := NewMat(p, f.K+f.L)
+	for i := f.K; i < f.K+f.L; i++ {
+		d.Set(i-f.K, i, f.Beta[i])
+	}
+	return d
+}
+
+// ZeroR returns the (k+l) x n matrix [0, R].
+func (f *GSVDFact) ZeroR() *Mat {
+	n, _ := f.Q.Dims()
+	r := NewMat(f.K+f.L, n)
+	for i := 0; i < f.K+f.L; i++ {
+		for j := i; j < f.K+f.L; j++ {
+			r.Set(i, n-f.K-f.L+j, f.R.At(i, j))
+		}
+	}
+	return r
+}
@@ -0,0 +1,39 @@
+package lapack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestGSVD(t *testing.T) {
+	testGSVD(t, randMat(15, 8), randMat(10, 8))
+}
+
+func TestGSVD_splitR(t *testing.T) {
+	// A has fewer rows than the rank of [A; B],
+	// so R is split between A and B.
+	testGSVD(t, randMat(4, 8), randMat(10, 8))
+}
+
+func testGSVD(t *testing.T, a, b *mat.Mat) {
+	f, err := GSVD(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, n := a.Dims()
+	p, _ := b.Dims()
+	testMatEq(t, mat.I(m), mat.Mul(mat.T(f.U), f.U))
+	testMatEq(t, mat.I(p), mat.Mul(mat.T(f.V), f.V))
+	testMatEq(t, mat.I(n), mat.Mul(mat.T(f.Q), f.Q))
+	for i := range f.Alpha {
+		if s := f.Alpha[i]*f.Alpha[i] + f.Beta[i]*f.Beta[i]; math.Abs(s-1) > 1e-9 {
+			t.Errorf("at %d: alpha^2 + beta^2 = %g", i, s)
+		}
+	}
+	// Check that A = U D1 [0, R] Q' and B = V D2 [0, R] Q'.
+	rqt := mat.Mul(f.ZeroR(), mat.T(f.Q))
+	testMatEq(t, a, mat.Mul(f.U, mat.Mul(f.D1(), rqt)))
+	testMatEq(t, b, mat.Mul(f.V, mat.Mul(f.D2(), rqt)))
+}
@@ -0,0 +1,365 @@
+package lapack
+
+// The functions in this file are equivalent to their namesakes
+// without the InPlace suffix but do not copy their inputs.
+// The matrix and vector arguments are overwritten and must not be used afterwards,
+// except through the results, which may share their memory.
+
+// SolveSquareInPlace is like SolveSquare but destroys a and b.
+// The solution is stored in b.
+func SolveSquareInPlace(a *Mat, b []float64) ([]float64, error) {
+	return SolveSquareInPlaceOpts(a, b, nil)
+}
+
+// SolveSquareInPlaceOpts is like SolveSquareInPlace but takes options.
+func SolveSquareInPlaceOpts(a *Mat, b []float64, opts *Opts) ([]float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveSquare(a, b))
+}
+
+// SolveSymmInPlace is like SolveSymm but destroys a and b.
+// The solution is stored in b.
+func SolveSymmInPlace(a *Mat, b []float64) ([]float64, error) {
+	return SolveSymmInPlaceOpts(a, b, nil)
+}
+
+// SolveSymmInPlaceOpts is like SolveSymmInPlace but takes options.
+func SolveSymmInPlaceOpts(a *Mat, b []float64, opts *Opts) ([]float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveSymm(a, b, opts.tri()))
+}
+
+// SolvePosDefInPlace is like SolvePosDef but destroys a and b.
+// The solution is stored in b.
+func SolvePosDefInPlace(a *Mat, b []float64) ([]float64, error) {
+	return SolvePosDefInPlaceOpts(a, b, nil)
+}
+
+// SolvePosDefInPlaceOpts is like SolvePosDefInPlace but takes options.
+func SolvePosDefInPlaceOpts(a *Mat, b []float64, opts *Opts) ([]float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solvePosDef(a, b, opts.tri()))
+}
+
+// SolveFullRankInPlace is like SolveFullRank but destroys a and b.
+// The capacity of b must be at least max(m, n).
+// The solution is stored in b.
+func SolveFullRankInPlace(a *Mat, b []float64) ([]float64, error) {
+	return SolveFullRankInPlaceOpts(a, b, nil)
+}
+
+// SolveFullRankInPlaceOpts is like SolveFullRankInPlace but takes options.
+func SolveFullRankInPlaceOpts(a *Mat, b []float64, opts *Opts) ([]float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	if err := errCap(b, max(m, n)); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveFullRank(a, b))
+}
+
+// SolveEpsInPlace is like SolveEps but destroys a and b.
+// The capacity of b must be at least max(m, n).
+// The solution is stored in b.
+func SolveEpsInPlace(a *Mat, b []float64, eps float64) ([]float64, error) {
+	return solveEpsInPlaceOpts(a, b, eps, nil)
+}
+
+// SolveInPlaceOpts is like SolveOpts but destroys a and b.
+// The capacity of b must be at least max(m, n).
+// The solution is stored in b.
+func SolveInPlaceOpts(a *Mat, b []float64, opts *Opts) ([]float64, error) {
+	return solveEpsInPlaceOpts(a, b, opts.eps(), opts)
+}
+
+func solveEpsInPlaceOpts(a *Mat, b []float64, eps float64, opts *Opts) ([]float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	if err := errCap(b, max(m, n)); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveEps(a, b, eps))
+}
+
+// LUInPlace is like LU but stores the factorization in a.
+func LUInPlace(a *Mat) (*LUFact, error) {
+	return LUInPlaceOpts(a, nil)
+}
+
+// LUInPlaceOpts is like LUInPlace but takes options.
+func LUInPlaceOpts(a *Mat, opts *Opts) (*LUFact, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return lu(a)
+}
+
+// QRInPlace is like QR but stores the factorization in a.
+func QRInPlace(a *Mat) (*QRFact, error) {
+	return QRInPlaceOpts(a, nil)
+}
+
+// QRInPlaceOpts is like QRInPlace but takes options.
+func QRInPlaceOpts(a *Mat, opts *Opts) (*QRFact, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return qr(a)
+}
+
+// LQInPlace is like LQ but stores the factorization in a.
+func LQInPlace(a *Mat) (*LQFact, error) {
+	return LQInPlaceOpts(a, nil)
+}
+
+// LQInPlaceOpts is like LQInPlace but takes options.
+func LQInPlaceOpts(a *Mat, opts *Opts) (*LQFact, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return lq(a)
+}
+
+// CholInPlace is like Chol but stores the factorization in a.
+func CholInPlace(a *Mat) (*CholFact, error) {
+	return CholInPlaceOpts(a, nil)
+}
+
+// CholInPlaceOpts is like CholInPlace but takes options.
+func CholInPlaceOpts(a *Mat, opts *Opts) (*CholFact, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return chol(a, opts.tri())
+}
+
+// LDLInPlace is like LDL but stores the factorization in a.
+func LDLInPlace(a *Mat) (*LDLFact, error) {
+	return LDLInPlaceOpts(a, nil)
+}
+
+// LDLInPlaceOpts is like LDLInPlace but takes options.
+func LDLInPlaceOpts(a *Mat, opts *Opts) (*LDLFact, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return ldl(a, opts.tri())
+}
+
+// SVDInPlace is like SVD but destroys a.
+func SVDInPlace(a *Mat) (u *Mat, s []float64, vt *Mat, err error) {
+	return SVDInPlaceOpts(a, nil)
+}
+
+// SVDInPlaceOpts is like SVDInPlace but takes options.
+func SVDInPlaceOpts(a *Mat, opts *Opts) (u *Mat, s []float64, vt *Mat, err error) {
+	if err := errBadMat(a); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, nil, err
+	}
+	return svd(a)
+}
+
+// EigSymmInPlace is like EigSymm but stores the eigenvectors in a.
+func EigSymmInPlace(a *Mat) (*Mat, []float64, error) {
+	return EigSymmInPlaceOpts(a, nil)
+}
+
+// EigSymmInPlaceOpts is like EigSymmInPlace but takes options.
+func EigSymmInPlaceOpts(a *Mat, opts *Opts) (*Mat, []float64, error) {
+	if err := errBadMat(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, nil, err
+	}
+	return eigSymm(a, opts.tri())
+}
+
+// InvertPosDefInPlace is like InvertPosDef but stores the inverse in a.
+func InvertPosDefInPlace(a *Mat) error {
+	return InvertPosDefInPlaceOpts(a, nil)
+}
+
+// InvertPosDefInPlaceOpts is like InvertPosDefInPlace but takes options.
+func InvertPosDefInPlaceOpts(a *Mat, opts *Opts) error {
+	if err := errBadMat(a); err != nil {
+		return err
+	}
+	if err := errNonPosDims(a); err != nil {
+		return err
+	}
+	if err := errNonSquare(a); err != nil {
+		return err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return err
+	}
+	if err := invertPosDef(a, opts.tri()); err != nil {
+		return err
+	}
+	_, err := opts.finiteResultMat(a, nil)
+	return err
+}
@@ -0,0 +1,74 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveSquareInPlace(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	x, err := SolveSquareInPlace(cloneMat(a), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, x)
+	// Solution is stored in b.
+	if &x[0] != &b[0] {
+		t.Errorf("solution not stored in b")
+	}
+}
+
+func TestSolveFullRankInPlace_capacity(t *testing.T) {
+	m, n := 100, 150
+	a, b, want, err := underDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Capacity is insufficient for solution.
+	if _, err := SolveFullRankInPlace(cloneMat(a), cloneSlice(b)); err == nil {
+		t.Fatal("expected error")
+	}
+	got, err := SolveFullRankInPlace(cloneMat(a), cloneSliceCap(b, n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestCholInPlace(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	chol, err := CholInPlace(cloneMat(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := chol.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func ExampleSolveSquareInPlace() {
+	a := &Mat{2, 2, []float64{1, 3, 2, 4}}
+	b := []float64{5, 11}
+	x, err := SolveSquareInPlace(a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g", x)
+	// Output:
+	// [1 2]
+}
@@ -11,21 +11,32 @@ type LDLFact struct {
 // Calls DSYTRF.
 // Equivalent to SolveSymm (calls DSYSV).
 func LDL(a Const) (*LDLFact, error) {
+	return LDLOpts(a, nil)
+}
+
+// LDLOpts is like LDL but takes options.
+func LDLOpts(a Const, opts *Opts) (*LDLFact, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
 	if err := errNonSquare(a); err != nil {
 		return nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
 		return nil, err
 	}
-	return ldl(cloneMat(a), DefaultTri)
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	return ldl(cloneMat(a), opts.tri())
 }
 
 func ldl(a *Mat, tri Triangle) (*LDLFact, error) {
 	n, _ := a.Dims()
-	piv, err := dsytrf(tri, n, a.Elems, n)
+	piv, err := dsytrf(nil, tri, n, a.Elems, n)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +49,10 @@ func (ldl *LDLFact) Solve(b []float64) ([]float64, error) {
 	if err := errIncompat(ldl.A, b); err != nil {
 		return nil, err
 	}
-	return ldl.solve(cloneSlice(b))
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(ldl.solve(cloneSlice(b)))
 }
 
 // b will be modified.
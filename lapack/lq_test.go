@@ -0,0 +1,97 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Minimum-norm solution to under-constrained system by LQ decomposition.
+func TestLQFact_Solve_underdetermined(t *testing.T) {
+	m, n := 100, 150
+	a, b, want, err := underDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lq, err := LQ(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := lq.Solve(false, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+// Minimum-residual solution to over-constrained system by LQ decomposition.
+func TestLQFact_Solve_overdetermined(t *testing.T) {
+	m, n := 150, 100
+	a, b, want, err := overDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Take LQ factorization of transpose.
+	lq, err := LQ(mat.T(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := lq.Solve(true, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestLQFact_LQ(t *testing.T) {
+	m, n := 100, 150
+	a := randMat(m, n)
+
+	lq, err := LQ(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := lq.Q()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that A = L Q.
+	testMatEq(t, a, mat.Mul(lq.L(), q))
+	// Check that Q has orthonormal rows.
+	testMatEq(t, mat.I(m), mat.Mul(q, mat.T(q)))
+}
+
+func ExampleLQFact_Solve() {
+	a := mat.NewRows([][]float64{
+		{4, 1, 2},
+		{2, 1, 0},
+	})
+	b_under := []float64{39, 19}
+	b_over := []float64{6, 7, 4}
+
+	lq, err := LQ(a)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	x_under, err := lq.Solve(false, b_under)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", x_under)
+
+	x_over, err := lq.Solve(true, b_over)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", x_over)
+	// Output:
+	// [8 3 2]
+	// [1 2]
+}
@@ -16,6 +16,9 @@ func LU(a Const) (*LUFact, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
 	return lu(cloneMat(a))
 }
 
@@ -38,7 +41,10 @@ func (lu *LUFact) Solve(t bool, b []float64) ([]float64, error) {
 	if err := errIncompatT(lu.A, t, b); err != nil {
 		return nil, err
 	}
-	return lu.solve(t, cloneSlice(b))
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(lu.solve(t, cloneSlice(b)))
 }
 
 // b will be modified.
@@ -0,0 +1,98 @@
+package lapack
+
+// SolveSquareMixed finds x such that A x = b where A is square and full-rank.
+// A is factorized in single precision and x is refined in double precision.
+// If refinement does not converge, or A is too badly conditioned for single precision,
+// the system is solved again in double precision as by SolveSquare.
+//
+// Returns the number of refinement iterations,
+// or a negative number if the system was solved in double precision
+// (see the description of ITER for DSGESV).
+// Calls DSGESV.
+func SolveSquareMixed(a Const, b []float64) (x []float64, iter int, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, 0, err
+	}
+	x, iter, err = solveSquareMixed(cloneMat(a), cloneSlice(b))
+	if x, err = finiteResult(x, err); err != nil {
+		return nil, 0, err
+	}
+	return x, iter, nil
+}
+
+// a and b will be modified.
+func solveSquareMixed(a *Mat, b []float64) ([]float64, int, error) {
+	n, _ := a.Dims()
+	x := make([]float64, n)
+	iter, err := dsgesv(n, 1, a.Elems, n, b, n, x, n)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, iter, nil
+}
+
+// SolvePosDefMixed finds x such that A x = b where A is symmetric and positive-definite.
+// A is factorized in single precision and x is refined in double precision.
+// If refinement does not converge, or A is too badly conditioned for single precision,
+// the system is solved again in double precision as by SolvePosDef.
+//
+// Returns the number of refinement iterations,
+// or a negative number if the system was solved in double precision
+// (see the description of ITER for DSPOSV).
+// Calls DSPOSV.
+func SolvePosDefMixed(a Const, b []float64) (x []float64, iter int, err error) {
+	return SolvePosDefMixedOpts(a, b, nil)
+}
+
+// SolvePosDefMixedOpts is like SolvePosDefMixed but takes options.
+func SolvePosDefMixedOpts(a Const, b []float64, opts *Opts) (x []float64, iter int, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, 0, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, 0, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, 0, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, 0, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, 0, err
+	}
+	x, iter, err = solvePosDefMixed(cloneMat(a), cloneSlice(b), opts.tri())
+	if x, err = opts.finiteResult(x, err); err != nil {
+		return nil, 0, err
+	}
+	return x, iter, nil
+}
+
+// a and b will be modified.
+func solvePosDefMixed(a *Mat, b []float64, tri Triangle) ([]float64, int, error) {
+	n, _ := a.Dims()
+	x := make([]float64, n)
+	iter, err := dsposv(tri, n, 1, a.Elems, n, b, n, x, n)
+	if err != nil {
+		return nil, 0, err
+	}
+	return x, iter, nil
+}
@@ -0,0 +1,66 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestSolveSquareMixed(t *testing.T) {
+	n := 100
+	// Well-conditioned matrix.
+	a := mat.Plus(mat.Scale(float64(n), mat.I(n)), randMat(n, n))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, iter, err := SolveSquareMixed(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iter < 0 {
+		t.Errorf("fell back to double precision: iter %d", iter)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolvePosDefMixed(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Plus(mat.Scale(float64(n), mat.I(n)), mat.Mul(mat.T(a), a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, iter, err := SolvePosDefMixed(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iter < 0 {
+		t.Errorf("fell back to double precision: iter %d", iter)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolveSquareMixed_fallback(t *testing.T) {
+	n := 10
+	// Hilbert matrix is too badly conditioned for single precision.
+	a := mat.New(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	b := mat.MulVec(a, randVec(n))
+
+	want, err := SolveSquare(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, iter, err := SolveSquareMixed(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iter >= 0 {
+		t.Errorf("want fallback to double precision, got %d iterations", iter)
+	}
+	testSliceEq(t, want, got)
+}
@@ -0,0 +1,128 @@
+package lapack
+
+// Opts controls the behaviour of the functions which accept it
+// (those with the suffix Opts).
+// Unlike the package-level defaults, it can be chosen per call.
+// A nil *Opts, or a zero or nil field, selects the package-level default.
+// The tolerances are pointers so that a tolerance of zero can be chosen.
+type Opts struct {
+	// Triangle of a symmetric matrix which is read.
+	// Default is DefaultTri.
+	Tri Triangle
+	// Skip checking that the matrix is symmetric.
+	// Only the triangle Tri is read.
+	NoSymmCheck bool
+	// Absolute and relative tolerance for the symmetry check.
+	// Nil selects EpsSymmAbs and EpsSymmRel.
+	// Zero for both requires the matrix to be exactly symmetric.
+	EpsSymmAbs, EpsSymmRel *float64
+	// Inverse maximum condition number used by SolveOpts.
+	// Nil selects DefaultEps.
+	Eps *float64
+	// Skip checking the arguments and solution for NaN and Inf.
+	NoFiniteCheck bool
+}
+
+func (opts *Opts) tri() Triangle {
+	if opts == nil || opts.Tri == 0 {
+		return DefaultTri
+	}
+	return opts.Tri
+}
+
+func (opts *Opts) eps() float64 {
+	if opts == nil || opts.Eps == nil {
+		return DefaultEps
+	}
+	return *opts.Eps
+}
+
+// Returns an error if the matrix is not symmetric,
+// unless the check is disabled.
+func (opts *Opts) errNonSymm(a Const) error {
+	if opts == nil {
+		return errNonSymm(a)
+	}
+	if opts.NoSymmCheck {
+		return nil
+	}
+	abs, rel := EpsSymmAbs, EpsSymmRel
+	if opts.EpsSymmAbs != nil {
+		abs = *opts.EpsSymmAbs
+	}
+	if opts.EpsSymmRel != nil {
+		rel = *opts.EpsSymmRel
+	}
+	return errNonSymmEps(a, abs, rel)
+}
+
+// Returns an error if the triangle is not valid.
+func (opts *Opts) errBadTri() error {
+	switch tri := opts.tri(); tri {
+	case UpperTri, LowerTri:
+		return nil
+	default:
+		return errBadTri(tri)
+	}
+}
+
+// Returns an error if an element of the matrix is not finite,
+// unless the check is disabled.
+func (opts *Opts) errNonFinite(a Const) error {
+	if opts != nil && opts.NoFiniteCheck {
+		return nil
+	}
+	return errNonFinite(a)
+}
+
+// Returns an error if an element in the triangle Tri is not finite,
+// unless the check is disabled.
+func (opts *Opts) errNonFiniteTri(a Const) error {
+	if opts != nil && opts.NoFiniteCheck {
+		return nil
+	}
+	return errNonFiniteTri(a, opts.tri(), false)
+}
+
+// Returns an error if an element in the given triangle is not finite,
+// unless the check is disabled.
+// The diagonal is not checked if unitDiag is true.
+func (opts *Opts) errNonFiniteTriOf(a Const, tri Triangle, unitDiag bool) error {
+	if opts != nil && opts.NoFiniteCheck {
+		return nil
+	}
+	return errNonFiniteTri(a, tri, unitDiag)
+}
+
+// Returns an error if an element of the vector is not finite,
+// unless the check is disabled.
+func (opts *Opts) errNonFiniteVec(b []float64) error {
+	if opts != nil && opts.NoFiniteCheck {
+		return nil
+	}
+	return errNonFiniteVec("vector", b)
+}
+
+// Like finiteResult unless the check is disabled.
+func (opts *Opts) finiteResult(x []float64, err error) ([]float64, error) {
+	if opts != nil && opts.NoFiniteCheck {
+		return x, err
+	}
+	return finiteResult(x, err)
+}
+
+// Like finiteResultMat unless the check is disabled.
+func (opts *Opts) finiteResultMat(x *Mat, err error) (*Mat, error) {
+	if opts != nil && opts.NoFiniteCheck {
+		return x, err
+	}
+	return finiteResultMat(x, err)
+}
+
+// Like finiteExpertResult unless the check is disabled.
+func (opts *Opts) finiteExpertResult(sol *ExpertSolution, err error) (*ExpertSolution, error) {
+	if opts != nil && opts.NoFiniteCheck {
+		return sol, err
+	}
+	return finiteExpertResult(sol, err)
+}
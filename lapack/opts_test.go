@@ -0,0 +1,85 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Returns a copy of a with the upper triangle set to garbage.
+func spoilUpper(a *mat.Mat) *mat.Mat {
+	n, _ := a.Dims()
+	b := mat.New(n, n)
+	mat.Copy(b, a)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			b.Set(i, j, 1e6)
+		}
+	}
+	return b
+}
+
+func TestSolveSymmOpts_lower(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	opts := &Opts{Tri: LowerTri, NoSymmCheck: true}
+	got, err := SolveSymmOpts(spoilUpper(a), b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestCholOpts_nonSymm(t *testing.T) {
+	n := 10
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+
+	// Symmetry check must reject the matrix unless disabled.
+	if _, err := CholOpts(spoilUpper(a), &Opts{Tri: LowerTri}); err == nil {
+		t.Fatal("expected error for non-symmetric matrix")
+	}
+	chol, err := CholOpts(spoilUpper(a), &Opts{Tri: LowerTri, NoSymmCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := randVec(n)
+	got, err := chol.Solve(mat.MulVec(a, want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestEigSymmOpts_lower(t *testing.T) {
+	n := 50
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+
+	v, d, err := EigSymmOpts(spoilUpper(a), &Opts{Tri: LowerTri, NoSymmCheck: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, a, mat.Mul(mat.Mul(v, mat.NewDiag(d)), mat.T(v)))
+}
+
+func TestSolveSymmOpts_zeroTol(t *testing.T) {
+	// Within EpsSymmRel of symmetric but not exactly symmetric.
+	a := mat.NewRows([][]float64{
+		{2, 1},
+		{1 + 1e-12, 2},
+	})
+	b := []float64{1, 1}
+
+	if _, err := SolveSymmOpts(a, b, &Opts{}); err != nil {
+		t.Fatalf("expected default tolerance to accept matrix, got %v", err)
+	}
+	zero := 0.0
+	if _, err := SolveSymmOpts(a, b, &Opts{EpsSymmAbs: &zero, EpsSymmRel: &zero}); err == nil {
+		t.Fatal("expected error for zero tolerance")
+	}
+}
@@ -0,0 +1,226 @@
+package lapack
+
+import "fmt"
+
+// SymmPacked describes a symmetric n x n matrix
+// by one of its triangles, stored in n(n+1)/2 elements.
+type SymmPacked struct {
+	// Dimension.
+	N int
+	// Which triangle is stored.
+	Tri Triangle
+	// Elements of the triangle, packed column by column.
+	// For the upper triangle, element (i, j) with i <= j resides at index (i + j(j+1)/2).
+	// For the lower triangle, element (i, j) with i >= j resides at index (i + j(2n-j-1)/2).
+	Elems []float64
+}
+
+// Allocates a packed symmetric matrix of all zeros.
+func NewSymmPacked(n int, tri Triangle) *SymmPacked {
+	elems := make([]float64, n*(n+1)/2)
+	return &SymmPacked{n, tri, elems}
+}
+
+// Pack copies one triangle of a symmetric matrix into packed storage.
+// Returns an error if the matrix is not square or not symmetric.
+func Pack(a Const, tri Triangle) (*SymmPacked, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSymm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	p := NewSymmPacked(n, tri)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			if !otherTri(i, j, tri) {
+				p.Set(i, j, a.At(i, j))
+			}
+		}
+	}
+	return p, nil
+}
+
+func (a *SymmPacked) Dims() (rows, cols int) {
+	return a.N, a.N
+}
+
+func (a *SymmPacked) At(i, j int) float64 {
+	return a.Elems[a.index(i, j)]
+}
+
+// Set modifies elements (i, j) and (j, i).
+func (a *SymmPacked) Set(i, j int, v float64) {
+	a.Elems[a.index(i, j)] = v
+}
+
+// Returns the packed index of element (i, j) or (j, i),
+// whichever is in the stored triangle.
+func (a *SymmPacked) index(i, j int) int {
+	if i < 0 || i >= a.N || j < 0 || j >= a.N {
+		panic(fmt.Sprintf("index out of range: (%d, %d) in %dx%d", i, j, a.N, a.N))
+	}
+	if otherTri(i, j, a.Tri) {
+		i, j = j, i
+	}
+	switch a.Tri {
+	case UpperTri:
+		return i + j*(j+1)/2
+	case LowerTri:
+		return i + j*(2*a.N-j-1)/2
+	default:
+		panic(fmt.Sprintf("unknown triangle: %v", a.Tri))
+	}
+}
+
+// Returns true if element (i, j) is not in the given triangle.
+func otherTri(i, j int, tri Triangle) bool {
+	switch tri {
+	case UpperTri:
+		return j < i
+	case LowerTri:
+		return i < j
+	default:
+		panic(fmt.Sprintf("unknown triangle: %v", tri))
+	}
+}
+
+func cloneSymmPacked(a *SymmPacked) *SymmPacked {
+	return &SymmPacked{a.N, a.Tri, cloneSlice(a.Elems)}
+}
+
+// SolveSymmPacked finds x such that A x = b
+// where A is symmetric and stored in packed format.
+// Calls DSPSV.
+func SolveSymmPacked(a *SymmPacked, b []float64) ([]float64, error) {
+	if err := errBadPacked(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(solveSymmPacked(cloneSymmPacked(a), cloneSlice(b)))
+}
+
+// a and b will be modified.
+func solveSymmPacked(a *SymmPacked, b []float64) ([]float64, error) {
+	err := dspsv(a.Tri, a.N, 1, a.Elems, b, a.N)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SolvePosDefPacked finds x such that A x = b
+// where A is symmetric, positive-definite and stored in packed format.
+// Calls DPPSV.
+func SolvePosDefPacked(a *SymmPacked, b []float64) ([]float64, error) {
+	if err := errBadPacked(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(solvePosDefPacked(cloneSymmPacked(a), cloneSlice(b)))
+}
+
+// a and b will be modified.
+func solvePosDefPacked(a *SymmPacked, b []float64) ([]float64, error) {
+	err := dppsv(a.Tri, a.N, 1, a.Elems, b, a.N)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// CholPackedFact describes a Cholesky factorization in packed format.
+// The triangular factor occupies the same triangle as the original matrix.
+type CholPackedFact struct {
+	A *SymmPacked
+}
+
+// CholPacked computes the Cholesky factorization A = L L' or A = U' U
+// of a symmetric, positive-definite matrix stored in packed format.
+// Calls DPPTRF.
+// Equivalent to SolvePosDefPacked (calls DPPSV).
+func CholPacked(a *SymmPacked) (*CholPackedFact, error) {
+	if err := errBadPacked(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return cholPacked(cloneSymmPacked(a))
+}
+
+// a will be modified.
+func cholPacked(a *SymmPacked) (*CholPackedFact, error) {
+	err := dpptrf(a.Tri, a.N, a.Elems)
+	if err != nil {
+		return nil, err
+	}
+	return &CholPackedFact{a}, nil
+}
+
+// Solve finds x such that A x = b where A is symmetric and positive-definite
+// given its packed Cholesky decomposition.
+// Calls DPPTRS.
+func (chol *CholPackedFact) Solve(b []float64) ([]float64, error) {
+	if err := errIncompat(chol.A, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(chol.solve(cloneSlice(b)))
+}
+
+// b will be modified.
+func (chol *CholPackedFact) solve(b []float64) ([]float64, error) {
+	n := chol.A.N
+	err := dpptrs(chol.A.Tri, n, 1, chol.A.Elems, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// EigSymmPacked computes the eigenvalue factorization
+// of a symmetric matrix stored in packed format.
+// Calls DSPEV.
+func EigSymmPacked(a *SymmPacked) (*Mat, []float64, error) {
+	if err := errBadPacked(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	return eigSymmPacked(cloneSymmPacked(a))
+}
+
+// a will be modified.
+func eigSymmPacked(a *SymmPacked) (*Mat, []float64, error) {
+	n := a.N
+	v := NewMat(n, n)
+	d, err := dspev(vectors, a.Tri, n, a.Elems, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, d, nil
+}
@@ -0,0 +1,122 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestPack(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		p, err := Pack(a, tri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p.Elems) != n*(n+1)/2 {
+			t.Fatalf("want %d elements, got %d", n*(n+1)/2, len(p.Elems))
+		}
+		testMatEq(t, a, p)
+	}
+}
+
+func TestSolveSymmPacked(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		p, err := Pack(a, tri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SolveSymmPacked(p, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestSolvePosDefPacked(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		p, err := Pack(a, tri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SolvePosDefPacked(p, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestCholPackedFact_Solve(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	p, err := Pack(a, LowerTri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chol, err := CholPacked(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := chol.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestEigSymmPacked(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+
+	p, err := Pack(a, UpperTri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, d, err := EigSymmPacked(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := mat.Mul(mat.Mul(v, mat.NewDiag(d)), mat.T(v))
+	testMatEq(t, a, got)
+}
+
+func ExampleSymmPacked() {
+	a := NewSymmPacked(3, LowerTri)
+	a.Set(0, 0, 7)
+	a.Set(1, 0, -2)
+	a.Set(1, 1, 6)
+	a.Set(2, 1, -2)
+	a.Set(2, 2, 5)
+
+	_, d, err := EigSymmPacked(a)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", d)
+	// Output:
+	// [3 6 9]
+}
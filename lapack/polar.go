@@ -0,0 +1,56 @@
+package lapack
+
+import "github.com/jvlmdr/lin-go/mat"
+
+// Polar computes the polar decomposition of an m x n matrix,
+// where U has orthonormal columns (m >= n) or rows (m < n)
+// and P is symmetric positive-semidefinite.
+// If m >= n, then A = U P and P is n x n.
+// If m < n, then A = P U and P is m x m.
+// If A is square, U is the nearest orthogonal matrix to A in Frobenius norm.
+//
+// With A = W S V', the factors are U = W V'
+// and P = V S V' (m >= n) or P = W S W' (m < n).
+// Calls DGESDD.
+func Polar(a Const) (u, p *Mat, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, err
+	}
+	m, n := a.Dims()
+	k := min(m, n)
+	w, s, vt, err := svd(cloneMat(a))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u = NewMat(m, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var x float64
+			for l := 0; l < k; l++ {
+				x += w.At(i, l) * vt.At(l, j)
+			}
+			u.Set(i, j, x)
+		}
+	}
+	// Singular vectors on the side of the smaller dimension.
+	var v Const = mat.T(vt)
+	if m < n {
+		v = w
+	}
+	p = NewMat(k, k)
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			var x float64
+			for l := 0; l < k; l++ {
+				x += v.At(i, l) * s[l] * v.At(j, l)
+			}
+			p.Set(i, j, x)
+			p.Set(j, i, x)
+		}
+	}
+	return u, p, nil
+}
@@ -0,0 +1,39 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestPolar(t *testing.T) {
+	m, n := 15, 10
+	a := randMat(m, n)
+	u, p, err := Polar(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that U has orthonormal columns and P is symmetric.
+	testMatEq(t, mat.I(n), mat.Mul(mat.T(u), u))
+	if !mat.IsSymmetric(p, 1e-9) {
+		t.Error("P not symmetric")
+	}
+	// Check that A = U P.
+	testMatEq(t, a, mat.Mul(u, p))
+}
+
+func TestPolar_fat(t *testing.T) {
+	m, n := 10, 15
+	a := randMat(m, n)
+	u, p, err := Polar(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that U has orthonormal rows and P is symmetric.
+	testMatEq(t, mat.I(m), mat.Mul(u, mat.T(u)))
+	if !mat.IsSymmetric(p, 1e-9) {
+		t.Error("P not symmetric")
+	}
+	// Check that A = P U.
+	testMatEq(t, a, mat.Mul(p, u))
+}
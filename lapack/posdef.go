@@ -3,6 +3,11 @@ package lapack
 // SolvePosDef finds x such that A x = b where A is symmetric and positive-definite.
 // Calls DPOSV.
 func SolvePosDef(a Const, b []float64) ([]float64, error) {
+	return SolvePosDefOpts(a, b, nil)
+}
+
+// SolvePosDefOpts is like SolvePosDef but takes options.
+func SolvePosDefOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,16 +17,25 @@ func SolvePosDef(a Const, b []float64) ([]float64, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
 		return nil, err
 	}
-	return solvePosDef(cloneMat(a), cloneSlice(b))
+	return opts.finiteResult(solvePosDef(cloneMat(a), cloneSlice(b), opts.tri()))
 }
 
 // a and b will be modified.
-func solvePosDef(a *Mat, b []float64) ([]float64, error) {
+func solvePosDef(a *Mat, b []float64, tri Triangle) ([]float64, error) {
 	n, _ := a.Dims()
-	err := dposv(n, 1, a.Elems, n, b, n)
+	err := dposv(tri, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,183 @@
+package lapack
+
+import "math"
+
+// ProcrustesOpts selects the family of transforms searched by Procrustes.
+// The zero value searches orthogonal matrices only.
+type ProcrustesOpts struct {
+	// Restrict to rotations (determinant +1).
+	NoReflect bool
+	// Allow a uniform scale factor.
+	Scale bool
+	// Allow a translation.
+	Translate bool
+}
+
+// Alignment describes the transform y = s R x + t
+// which maps a point x onto a point y.
+type Alignment struct {
+	// Orthogonal d x d matrix.
+	R *Mat
+	// Scale factor, one if not estimated.
+	Scale float64
+	// Translation, zero if not estimated.
+	Trans []float64
+	// Frobenius norm of the residual over all points.
+	Resid float64
+}
+
+// Apply transforms every row of an n x d matrix of points.
+func (t *Alignment) Apply(a Const) *Mat {
+	m, d := a.Dims()
+	b := NewMat(m, d)
+	for i := 0; i < m; i++ {
+		for j := 0; j < d; j++ {
+			x := t.Trans[j]
+			for k := 0; k < d; k++ {
+				x += t.Scale * t.R.At(j, k) * a.At(i, k)
+			}
+			b.Set(i, j, x)
+		}
+	}
+	return b
+}
+
+// Procrustes finds the transform which minimizes
+// the sum of squared distances from the rows of A, transformed, to the rows of B.
+// A and B are n x d matrices of corresponding points.
+// A nil *ProcrustesOpts is equivalent to the zero value.
+// Calls DGESDD.
+func Procrustes(a, b Const, opts *ProcrustesOpts) (*Alignment, error) {
+	if opts == nil {
+		opts = new(ProcrustesOpts)
+	}
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errDimsNotEq(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	n, d := a.Dims()
+
+	// Subtract the means.
+	mu, nu := make([]float64, d), make([]float64, d)
+	if opts.Translate {
+		for j := 0; j < d; j++ {
+			for i := 0; i < n; i++ {
+				mu[j] += a.At(i, j)
+				nu[j] += b.At(i, j)
+			}
+			mu[j] /= float64(n)
+			nu[j] /= float64(n)
+		}
+	}
+	// Compute H = A' B of the centered points.
+	h := NewMat(d, d)
+	var sqrNormA float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			x := a.At(i, j) - mu[j]
+			sqrNormA += x * x
+			for k := 0; k < d; k++ {
+				h.Set(j, k, h.At(j, k)+x*(b.At(i, k)-nu[k]))
+			}
+		}
+	}
+
+	u, s, vt, err := svd(h)
+	if err != nil {
+		return nil, err
+	}
+	// R = V D U', where D flips the least significant direction
+	// if V U' is a reflection.
+	sign := make([]float64, d)
+	for k := range sign {
+		sign[k] = 1
+	}
+	r := rotation(u, vt, sign)
+	if opts.NoReflect {
+		det, err := detSign(r)
+		if err != nil {
+			return nil, err
+		}
+		if det < 0 {
+			sign[d-1] = -1
+			r = rotation(u, vt, sign)
+		}
+	}
+
+	scale := 1.0
+	if opts.Scale && sqrNormA > 0 {
+		var tr float64
+		for k := range s {
+			tr += sign[k] * s[k]
+		}
+		scale = tr / sqrNormA
+	}
+	// t = nu - s R mu.
+	trans := make([]float64, d)
+	for i := 0; i < d; i++ {
+		trans[i] = nu[i]
+		for j := 0; j < d; j++ {
+			trans[i] -= scale * r.At(i, j) * mu[j]
+		}
+	}
+
+	t := &Alignment{R: r, Scale: scale, Trans: trans}
+	y := t.Apply(a)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			t.Resid = math.Hypot(t.Resid, y.At(i, j)-b.At(i, j))
+		}
+	}
+	return t, nil
+}
+
+// Kabsch finds the rotation and translation
+// which best align the rows of A to the rows of B.
+// Equivalent to Procrustes with NoReflect and Translate.
+func Kabsch(a, b Const) (*Alignment, error) {
+	return Procrustes(a, b, &ProcrustesOpts{NoReflect: true, Translate: true})
+}
+
+// Returns V diag(sign) U'.
+func rotation(u, vt *Mat, sign []float64) *Mat {
+	d := len(sign)
+	r := NewMat(d, d)
+	for i := 0; i < d; i++ {
+		for j := 0; j < d; j++ {
+			var x float64
+			for k := 0; k < d; k++ {
+				x += vt.At(k, i) * sign[k] * u.At(j, k)
+			}
+			r.Set(i, j, x)
+		}
+	}
+	return r
+}
+
+// Returns the sign of the determinant of a non-singular square matrix.
+// Calls DGETRF.
+func detSign(a *Mat) (float64, error) {
+	f, err := lu(cloneMat(a))
+	if err != nil {
+		return 0, err
+	}
+	sign := 1.0
+	for i, p := range f.Piv {
+		// Pivot indices are one-based.
+		if p != i+1 {
+			sign = -sign
+		}
+		if f.A.At(i, i) < 0 {
+			sign = -sign
+		}
+	}
+	return sign, nil
+}
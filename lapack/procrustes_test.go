@@ -0,0 +1,77 @@
+package lapack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Returns a random rotation.
+func randRot(d int) *mat.Mat {
+	q, _, err := Polar(randMat(d, d))
+	if err != nil {
+		panic(err)
+	}
+	r := mat.New(d, d)
+	mat.Copy(r, q)
+	if sign, err := detSign(q); err != nil {
+		panic(err)
+	} else if sign < 0 {
+		mat.SetCol(r, 0, mat.Col(mat.Scale(-1, r), 0))
+	}
+	return r
+}
+
+func TestProcrustes(t *testing.T) {
+	n, d := 20, 3
+	a := randMat(n, d)
+	r := randRot(d)
+	s := 2.5
+	trans := []float64{1, -2, 3}
+	want := &Alignment{R: cloneMat(r), Scale: s, Trans: trans}
+	b := want.Apply(a)
+
+	opts := &ProcrustesOpts{NoReflect: true, Scale: true, Translate: true}
+	got, err := Procrustes(a, b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, r, got.R)
+	if math.Abs(s-got.Scale) > 1e-9 {
+		t.Errorf("scale: want %g, got %g", s, got.Scale)
+	}
+	testSliceEq(t, trans, got.Trans)
+	if got.Resid > 1e-9 {
+		t.Errorf("residual: want 0, got %g", got.Resid)
+	}
+}
+
+func TestKabsch_noReflect(t *testing.T) {
+	n, d := 20, 3
+	a := randMat(n, d)
+	// Reflect the points.
+	b := mat.New(n, d)
+	mat.Copy(b, a)
+	mat.SetCol(b, 0, mat.Col(mat.Scale(-1, a), 0))
+
+	// Orthogonal Procrustes recovers the reflection exactly.
+	refl, err := Procrustes(a, b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refl.Resid > 1e-9 {
+		t.Errorf("reflection: want zero residual, got %g", refl.Resid)
+	}
+
+	// Kabsch must find a rotation.
+	rot, err := Kabsch(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sign, err := detSign(rot.R); err != nil {
+		t.Fatal(err)
+	} else if sign < 0 {
+		t.Error("want rotation, got reflection")
+	}
+}
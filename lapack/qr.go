@@ -9,6 +9,12 @@ type QRFact struct {
 // Computes QR factorization.
 // Calls DGEQRF.
 func QR(a Const) (*QRFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
 	return qr(cloneMat(a))
 }
 
@@ -37,13 +43,17 @@ func (qr *QRFact) Solve(t bool, b []float64) ([]float64, error) {
 	if err := errIncompatT(qr.A, t, b); err != nil {
 		return nil, err
 	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
 	m, n := qr.A.Dims()
-	return qr.solve(t, cloneSliceCap(b, max(m, n)))
+	return finiteResult(qr.solve(nil, t, cloneSliceCap(b, max(m, n))))
 }
 
 // b will be modified.
 // b must have capacity for solution.
-func (qr *QRFact) solve(t bool, b []float64) ([]float64, error) {
+// The workspace of DORMQR is reused if ws is not nil.
+func (qr *QRFact) solve(ws *Workspace, t bool, b []float64) ([]float64, error) {
 	m, n := qr.A.Dims()
 	// In order to be able to solve systems with a QR factorization,
 	// the matrix must be skinny (otherwise use LQ, or QR of transpose).
@@ -57,7 +67,7 @@ func (qr *QRFact) solve(t bool, b []float64) ([]float64, error) {
 		var err error
 
 		// b <- Q' b
-		err = dormqr(left, true, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
+		err = dormqr(ws, left, true, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +91,7 @@ func (qr *QRFact) solve(t bool, b []float64) ([]float64, error) {
 			return nil, err
 		}
 		// b <- Q b
-		err = dormqr(left, false, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
+		err = dormqr(ws, left, false, m, 1, n, qr.A.Elems, m, qr.Tau, b, len(b))
 		if err != nil {
 			return nil, err
 		}
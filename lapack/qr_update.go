@@ -0,0 +1,337 @@
+package lapack
+
+import "math"
+
+// QRFullFact describes a QR factorization A = Q R
+// with explicit factors that can be updated
+// when A is modified by a rank-one matrix or a row or column is inserted or deleted.
+//
+// Q is m x m orthogonal and R is m x n upper-triangular.
+type QRFullFact struct {
+	Q *Mat
+	R *Mat
+}
+
+// QRFull computes a QR factorization with explicit factors.
+// Calls DGEQRF and DORMQR.
+func QRFull(a Const) (*QRFullFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	f, err := qr(cloneMat(a))
+	if err != nil {
+		return nil, err
+	}
+	return f.Full()
+}
+
+// Full forms the explicit factors of the QR factorization.
+// Calls DORMQR.
+func (qr *QRFact) Full() (*QRFullFact, error) {
+	m, n := qr.A.Dims()
+	k := min(m, n)
+	// Q = Q I
+	q := NewMat(m, m)
+	for i := 0; i < m; i++ {
+		q.Set(i, i, 1)
+	}
+	err := dormqr(nil, left, false, m, m, k, qr.A.Elems, m, qr.Tau, q.Elems, m)
+	if err != nil {
+		return nil, err
+	}
+	r := NewMat(m, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i <= min(j, m-1); i++ {
+			r.Set(i, j, qr.A.At(i, j))
+		}
+	}
+	return &QRFullFact{q, r}, nil
+}
+
+// Solves a linear system using QR decomposition.
+// Matrix must be m x n with m >= n (i.e. "skinny")
+// for R to be full rank and square.
+// See QRFact.Solve.
+func (qr *QRFullFact) Solve(t bool, b []float64) ([]float64, error) {
+	if err := errIncompatT(qr.R, t, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(qr.solve(t, b))
+}
+
+func (qr *QRFullFact) solve(t bool, b []float64) ([]float64, error) {
+	m, n := qr.R.Dims()
+	if m < n {
+		return nil, errBadShape(m, n)
+	}
+
+	if !t {
+		// x = R \ (Q' b)
+		y := make([]float64, m)
+		for j := 0; j < m; j++ {
+			for i := 0; i < m; i++ {
+				y[j] += qr.Q.At(i, j) * b[i]
+			}
+		}
+		if err := dtrtrs(UpperTri, false, nonUnitDiag, n, 1, qr.R.Elems, m, y, m); err != nil {
+			return nil, err
+		}
+		return y[:n], nil
+	}
+
+	// x = Q (R' \ b)
+	y := cloneSlice(b)
+	if err := dtrtrs(UpperTri, true, nonUnitDiag, n, 1, qr.R.Elems, m, y, n); err != nil {
+		return nil, err
+	}
+	x := make([]float64, m)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			x[i] += qr.Q.At(i, j) * y[j]
+		}
+	}
+	return x, nil
+}
+
+// Update modifies the factorization of A to be that of A + u v'.
+// Takes O(m^2 + mn) time.
+func (qr *QRFullFact) Update(u, v []float64) error {
+	if err := errIncompat(qr.R, u); err != nil {
+		return err
+	}
+	if err := errIncompatT(qr.R, true, v); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", u); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", v); err != nil {
+		return err
+	}
+	m, n := qr.R.Dims()
+	// w <- Q' u
+	w := make([]float64, m)
+	for j := 0; j < m; j++ {
+		for i := 0; i < m; i++ {
+			w[j] += qr.Q.At(i, j) * u[i]
+		}
+	}
+	// Reduce w to a multiple of e1, making R upper Hessenberg.
+	for k := m - 1; k > 0; k-- {
+		c, s, r := givens(w[k-1], w[k])
+		w[k-1], w[k] = r, 0
+		rotRows(qr.R, k-1, k, c, s, k-1)
+		rotCols(qr.Q, k-1, k, c, s)
+	}
+	// R <- R + w[0] e1 v'
+	for j := 0; j < n; j++ {
+		qr.R.Set(0, j, qr.R.At(0, j)+w[0]*v[j])
+	}
+	// Restore R to upper-triangular.
+	qr.hessToTri(0)
+	return nil
+}
+
+// InsertCol modifies the factorization of A
+// to be that of A with x inserted before column j.
+// If j is n, x is appended.
+// Takes O(m^2) time.
+func (qr *QRFullFact) InsertCol(j int, x []float64) error {
+	if err := errIncompat(qr.R, x); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", x); err != nil {
+		return err
+	}
+	m, n := qr.R.Dims()
+	if j < 0 || j > n {
+		return errIndex(j, n+1)
+	}
+	// w <- Q' x
+	w := make([]float64, m)
+	for p := 0; p < m; p++ {
+		for i := 0; i < m; i++ {
+			w[p] += qr.Q.At(i, p) * x[i]
+		}
+	}
+	r := NewMat(m, n+1)
+	for q := 0; q < n+1; q++ {
+		switch {
+		case q < j:
+			copy(r.Elems[q*m:(q+1)*m], qr.R.Elems[q*m:(q+1)*m])
+		case q == j:
+			copy(r.Elems[q*m:(q+1)*m], w)
+		default:
+			copy(r.Elems[q*m:(q+1)*m], qr.R.Elems[(q-1)*m:q*m])
+		}
+	}
+	qr.R = r
+	// Zero the new column below the diagonal.
+	for k := m - 1; k > j; k-- {
+		c, s, _ := givens(r.At(k-1, j), r.At(k, j))
+		rotRows(r, k-1, k, c, s, j)
+		r.Set(k, j, 0)
+		rotCols(qr.Q, k-1, k, c, s)
+	}
+	return nil
+}
+
+// DeleteCol modifies the factorization of A
+// to be that of A with column j removed.
+// Takes O(m^2) time.
+func (qr *QRFullFact) DeleteCol(j int) error {
+	m, n := qr.R.Dims()
+	if j < 0 || j >= n {
+		return errIndex(j, n)
+	}
+	if n == 1 {
+		return errBadShape(m, 0)
+	}
+	r := NewMat(m, n-1)
+	copy(r.Elems[:j*m], qr.R.Elems[:j*m])
+	copy(r.Elems[j*m:], qr.R.Elems[(j+1)*m:])
+	qr.R = r
+	// Columns from j onwards are upper Hessenberg.
+	qr.hessToTri(j)
+	return nil
+}
+
+// InsertRow modifies the factorization of A
+// to be that of A with x' inserted before row i.
+// If i is m, x' is appended.
+// Takes O(m^2) time.
+func (qr *QRFullFact) InsertRow(i int, x []float64) error {
+	if err := errIncompatT(qr.R, true, x); err != nil {
+		return err
+	}
+	if err := errNonFiniteVec("vector", x); err != nil {
+		return err
+	}
+	m, n := qr.R.Dims()
+	if i < 0 || i > m {
+		return errIndex(i, m+1)
+	}
+	// [x'; A] = [1, 0; 0, Q] [x'; R]
+	r := NewMat(m+1, n)
+	for q := 0; q < n; q++ {
+		r.Set(0, q, x[q])
+		for p := 0; p < m; p++ {
+			r.Set(p+1, q, qr.R.At(p, q))
+		}
+	}
+	// Move first row of [1, 0; 0, Q] to row i.
+	q := NewMat(m+1, m+1)
+	q.Set(i, 0, 1)
+	for p := 0; p < m; p++ {
+		dst := p
+		if p >= i {
+			dst++
+		}
+		for k := 0; k < m; k++ {
+			q.Set(dst, k+1, qr.Q.At(p, k))
+		}
+	}
+	qr.Q, qr.R = q, r
+	// [x'; R] is upper Hessenberg.
+	qr.hessToTri(0)
+	return nil
+}
+
+// DeleteRow modifies the factorization of A
+// to be that of A with row i removed.
+// Takes O(m^2) time.
+func (qr *QRFullFact) DeleteRow(i int) error {
+	m, n := qr.R.Dims()
+	if i < 0 || i >= m {
+		return errIndex(i, m)
+	}
+	if m == 1 {
+		return errBadShape(0, n)
+	}
+	// Reduce row i of Q to a multiple of e1', making R upper Hessenberg.
+	w := row(qr.Q, i)
+	for k := m - 1; k > 0; k-- {
+		c, s, r := givens(w[k-1], w[k])
+		w[k-1], w[k] = r, 0
+		rotRows(qr.R, k-1, k, c, s, k-1)
+		rotCols(qr.Q, k-1, k, c, s)
+	}
+	// Now A = Q R with Q = [+-e_i, Q1], remove first column and row i of Q
+	// and first row of R.
+	q := NewMat(m-1, m-1)
+	for p, dst := 0, 0; p < m; p++ {
+		if p == i {
+			continue
+		}
+		for k := 1; k < m; k++ {
+			q.Set(dst, k-1, qr.Q.At(p, k))
+		}
+		dst++
+	}
+	r := NewMat(m-1, n)
+	for k := 0; k < n; k++ {
+		for p := 1; p < m; p++ {
+			r.Set(p-1, k, qr.R.At(p, k))
+		}
+	}
+	qr.Q, qr.R = q, r
+	return nil
+}
+
+// Restores R to upper-triangular form
+// given that it is upper Hessenberg in columns j onwards.
+func (qr *QRFullFact) hessToTri(j int) {
+	m, n := qr.R.Dims()
+	for k := j; k < min(m-1, n); k++ {
+		c, s, _ := givens(qr.R.At(k, k), qr.R.At(k+1, k))
+		rotRows(qr.R, k, k+1, c, s, k)
+		qr.R.Set(k+1, k, 0)
+		rotCols(qr.Q, k, k+1, c, s)
+	}
+}
+
+// Returns c, s and r such that [c, s; -s, c] [a; b] = [r; 0].
+func givens(a, b float64) (c, s, r float64) {
+	if b == 0 {
+		return 1, 0, a
+	}
+	r = math.Hypot(a, b)
+	return a / r, b / r, r
+}
+
+// Applies the rotation G = [c, s; -s, c] to rows i and k of A,
+// in columns j onwards.
+func rotRows(a *Mat, i, k int, c, s float64, j int) {
+	_, n := a.Dims()
+	for q := max(j, 0); q < n; q++ {
+		x, y := a.At(i, q), a.At(k, q)
+		a.Set(i, q, c*x+s*y)
+		a.Set(k, q, -s*x+c*y)
+	}
+}
+
+// Applies the rotation G' to columns i and k of Q.
+func rotCols(a *Mat, i, k int, c, s float64) {
+	m, _ := a.Dims()
+	for p := 0; p < m; p++ {
+		x, y := a.At(p, i), a.At(p, k)
+		a.Set(p, i, c*x+s*y)
+		a.Set(p, k, -s*x+c*y)
+	}
+}
+
+// Returns a copy of row i.
+func row(a Const, i int) []float64 {
+	_, n := a.Dims()
+	x := make([]float64, n)
+	for j := range x {
+		x[j] = a.At(i, j)
+	}
+	return x
+}
@@ -0,0 +1,143 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Checks that Q is orthogonal, R is upper-triangular and A = Q R.
+func testQRFull(t *testing.T, a Const, qr *QRFullFact) {
+	m, n := a.Dims()
+	testMatEq(t, mat.I(m), mat.Mul(mat.T(qr.Q), qr.Q))
+	for j := 0; j < n; j++ {
+		for i := j + 1; i < m; i++ {
+			if qr.R.At(i, j) != 0 {
+				t.Fatalf("R not upper triangular: at %d, %d: %.6g", i, j, qr.R.At(i, j))
+			}
+		}
+	}
+	testMatEq(t, a, mat.Mul(qr.Q, qr.R))
+}
+
+func TestQRFull(t *testing.T) {
+	m, n := 60, 40
+	a := randMat(m, n)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testQRFull(t, a, qr)
+}
+
+func TestQRFullFact_Solve(t *testing.T) {
+	m, n := 60, 40
+	a := randMat(m, n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := qr.Solve(false, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestQRFullFact_Update(t *testing.T) {
+	m, n := 60, 40
+	a := randMat(m, n)
+	u, v := randVec(m), randVec(n)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.Update(u, v); err != nil {
+		t.Fatal(err)
+	}
+	a = mat.Plus(a, mat.Mul(mat.NewCols([][]float64{u}), mat.NewRows([][]float64{v})))
+	testQRFull(t, a, qr)
+}
+
+func TestQRFullFact_InsertCol(t *testing.T) {
+	m, n, j := 60, 40, 15
+	a := randMat(m, n)
+	x := randVec(m)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.InsertCol(j, x); err != nil {
+		t.Fatal(err)
+	}
+	cols := make([][]float64, 0, n+1)
+	for k := 0; k < n; k++ {
+		if k == j {
+			cols = append(cols, x)
+		}
+		cols = append(cols, mat.Col(a, k))
+	}
+	testQRFull(t, mat.NewCols(cols), qr)
+}
+
+func TestQRFullFact_DeleteCol(t *testing.T) {
+	m, n, j := 60, 40, 15
+	a := randMat(m, n)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.DeleteCol(j); err != nil {
+		t.Fatal(err)
+	}
+	cols := make([][]float64, 0, n-1)
+	for k := 0; k < n; k++ {
+		if k != j {
+			cols = append(cols, mat.Col(a, k))
+		}
+	}
+	testQRFull(t, mat.NewCols(cols), qr)
+}
+
+func TestQRFullFact_InsertRow(t *testing.T) {
+	m, n, i := 60, 40, 25
+	a := randMat(m, n)
+	x := randVec(n)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.InsertRow(i, x); err != nil {
+		t.Fatal(err)
+	}
+	rows := make([][]float64, 0, m+1)
+	for k := 0; k < m; k++ {
+		if k == i {
+			rows = append(rows, x)
+		}
+		rows = append(rows, row(a, k))
+	}
+	testQRFull(t, mat.NewRows(rows), qr)
+}
+
+func TestQRFullFact_DeleteRow(t *testing.T) {
+	m, n, i := 60, 40, 25
+	a := randMat(m, n)
+	qr, err := QRFull(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.DeleteRow(i); err != nil {
+		t.Fatal(err)
+	}
+	rows := make([][]float64, 0, m-1)
+	for k := 0; k < m; k++ {
+		if k != i {
+			rows = append(rows, row(a, k))
+		}
+	}
+	testQRFull(t, mat.NewRows(rows), qr)
+}
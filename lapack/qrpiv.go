@@ -0,0 +1,159 @@
+package lapack
+
+import "math"
+
+// QRPivFact describes a QR factorization with column pivoting, A P = Q R.
+// The diagonal of R is non-increasing in magnitude,
+// which reveals the numerical rank of A.
+type QRPivFact struct {
+	A   *Mat
+	Tau []float64
+	// Column j of A P is column Perm[j] of A.
+	Perm []int
+}
+
+// QRPiv computes a QR factorization with column pivoting.
+// Unlike QR, the matrix can have any shape and any rank.
+// Calls DGEQP3.
+func QRPiv(a Const) (*QRPivFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	return qrPiv(cloneMat(a))
+}
+
+// a will be modified.
+func qrPiv(a *Mat) (*QRPivFact, error) {
+	m, n := a.Dims()
+	tau := make([]float64, min(m, n))
+
+	jpvt, err := dgeqp3(m, n, a.Elems, m, tau)
+	if err != nil {
+		return nil, err
+	}
+	// Convert to zero-based indices.
+	for j := range jpvt {
+		jpvt[j]--
+	}
+	return &QRPivFact{a, tau, jpvt}, nil
+}
+
+// Rank estimates the numerical rank of A as the number of diagonal elements of R
+// whose magnitude exceeds eps times that of the first.
+func (qr *QRPivFact) Rank(eps float64) int {
+	m, n := qr.A.Dims()
+	k := min(m, n)
+	r0 := math.Abs(qr.A.At(0, 0))
+	if r0 == 0 {
+		return 0
+	}
+	for i := 1; i < k; i++ {
+		if math.Abs(qr.A.At(i, i)) <= eps*r0 {
+			return i
+		}
+	}
+	return k
+}
+
+// R returns a copy of the upper-triangular factor.
+// It has size min(m, n) x n.
+func (qr *QRPivFact) R() *Mat {
+	m, n := qr.A.Dims()
+	k := min(m, n)
+	r := NewMat(k, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i <= min(j, k-1); i++ {
+			r.Set(i, j, qr.A.At(i, j))
+		}
+	}
+	return r
+}
+
+// Solve finds a basic solution x which minimizes ||A x - b||
+// using the leading r x r block of R, where r = Rank(eps).
+// At most r elements of x are non-zero.
+// Calls DORMQR and DTRTRS.
+//
+// The basic solution is not in general the minimum-norm solution (see SolveQRPiv).
+func (qr *QRPivFact) Solve(b []float64, eps float64) ([]float64, error) {
+	if err := errIncompat(qr.A, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(qr.solve(cloneSlice(b), eps))
+}
+
+// b will be modified.
+func (qr *QRPivFact) solve(b []float64, eps float64) ([]float64, error) {
+	m, n := qr.A.Dims()
+	k := min(m, n)
+	r := qr.Rank(eps)
+
+	x := make([]float64, n)
+	if r == 0 {
+		return x, nil
+	}
+
+	// b <- Q' b
+	err := dormqr(nil, left, true, m, 1, k, qr.A.Elems, m, qr.Tau, b, m)
+	if err != nil {
+		return nil, err
+	}
+	// b[:r] <- R11 \ b[:r]
+	err = dtrtrs(UpperTri, false, nonUnitDiag, r, 1, qr.A.Elems, m, b, m)
+	if err != nil {
+		return nil, err
+	}
+	// Undo permutation.
+	for j := 0; j < r; j++ {
+		x[qr.Perm[j]] = b[j]
+	}
+	return x, nil
+}
+
+// SolveQRPiv finds the minimum-norm x which minimizes ||A x - b||
+// using a complete orthogonal factorization built on QR with column pivoting.
+// The effective rank is the order of the largest leading triangular block of R
+// with condition number less than 1/eps.
+// Returns the solution and the effective rank.
+// Calls DGELSY (eps is the "rcond" parameter).
+//
+// The solution is the same as that of SolveEps, which uses the SVD instead.
+// For a basic solution with at most rank non-zero elements, see QRPivFact.Solve.
+func SolveQRPiv(a Const, b []float64, eps float64) ([]float64, int, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, 0, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, 0, err
+	}
+	m, n := a.Dims()
+	x, rank, err := solveQRPiv(cloneMat(a), cloneSliceCap(b, max(m, n)), eps)
+	if x, err = finiteResult(x, err); err != nil {
+		return nil, 0, err
+	}
+	return x, rank, nil
+}
+
+// a and b will be modified.
+// b must have capacity for solution.
+func solveQRPiv(a *Mat, b []float64, eps float64) ([]float64, int, error) {
+	m, n := a.Dims()
+	b = b[:max(m, n)]
+	rank, err := dgelsy(m, n, 1, a.Elems, m, b, len(b), eps)
+	if err != nil {
+		return nil, 0, err
+	}
+	return b[:n], rank, nil
+}
@@ -0,0 +1,144 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Random m x n matrix of rank r.
+func randLowRank(m, n, r int) *mat.Mat {
+	return mat.Mul(randMat(m, r), randMat(r, n))
+}
+
+func TestQRPivFact(t *testing.T) {
+	m, n := 150, 100
+	a := randMat(m, n)
+
+	qr, err := QRPiv(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Check that A P = Q R by comparing norms of columns,
+	// since Q is orthogonal.
+	r := qr.R()
+	for j := 0; j < n; j++ {
+		want := mat.Col(a, qr.Perm[j])
+		got := mat.Col(r, j)
+		if !epsEq(sqrNorm(want), sqrNorm(got), 1e-6) {
+			t.Errorf("column %d: want norm %.6g, got %.6g", j, sqrNorm(want), sqrNorm(got))
+		}
+	}
+}
+
+func TestQRPivFact_Rank(t *testing.T) {
+	m, n, k := 100, 80, 30
+	a := randLowRank(m, n, k)
+
+	qr, err := QRPiv(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := qr.Rank(1e-10); got != k {
+		t.Errorf("want rank %d, got %d", k, got)
+	}
+}
+
+func TestQRPivFact_Solve(t *testing.T) {
+	m, n, k := 100, 80, 30
+	a := randLowRank(m, n, k)
+	b := randVec(m)
+
+	qr, err := QRPiv(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := qr.Solve(b, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Basic solution has at most rank non-zero elements.
+	var nnz int
+	for _, xi := range x {
+		if xi != 0 {
+			nnz++
+		}
+	}
+	if nnz > k {
+		t.Errorf("want at most %d non-zero elements, got %d", k, nnz)
+	}
+
+	// Residual should match that of minimum-norm solution.
+	y, err := SolveEps(a, b, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqrNorm(minus(mat.MulVec(a, y), b))
+	got := sqrNorm(minus(mat.MulVec(a, x), b))
+	if !epsEq(want, got, 1e-6) {
+		t.Errorf("residual: want %.6g, got %.6g", want, got)
+	}
+}
+
+func TestSolveQRPiv(t *testing.T) {
+	m, n, k := 100, 80, 30
+	a := randLowRank(m, n, k)
+	b := randVec(m)
+
+	want, err := SolveEps(a, b, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, rank, err := SolveQRPiv(a, b, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rank != k {
+		t.Errorf("want rank %d, got %d", k, rank)
+	}
+	testSliceEq(t, want, got)
+}
+
+func sqrNorm(x []float64) float64 {
+	var s float64
+	for _, xi := range x {
+		s += xi * xi
+	}
+	return s
+}
+
+func minus(x, y []float64) []float64 {
+	z := make([]float64, len(x))
+	for i := range x {
+		z[i] = x[i] - y[i]
+	}
+	return z
+}
+
+func ExampleQRPivFact_Solve() {
+	// Second column is twice the first.
+	a := mat.NewRows([][]float64{
+		{1, 2},
+		{1, 2},
+		{1, 2},
+	})
+	b := []float64{2, 4, 6}
+
+	qr, err := QRPiv(a)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(qr.Rank(1e-12))
+	x, err := qr.Solve(b, 1e-12)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", x)
+	// Output:
+	// 1
+	// [0 2]
+}
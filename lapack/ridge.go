@@ -0,0 +1,287 @@
+package lapack
+
+import "math"
+
+// SolveRidge finds x which minimizes ||A x - b||^2 + lambda ||x||^2.
+// Solves the augmented least-squares problem [A; sqrt(lambda) I] x = [b; 0].
+// Lambda must be non-negative, and A must have full rank if it is zero.
+// Calls DGELS.
+//
+// To solve for many values of lambda, use NewRidge, which takes a single SVD.
+func SolveRidge(a Const, b []float64, lambda float64) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	if err := errNegLambda(lambda); err != nil {
+		return nil, err
+	}
+
+	m, n := a.Dims()
+	aug := NewMat(m+n, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			aug.Set(i, j, a.At(i, j))
+		}
+	}
+	r := math.Sqrt(lambda)
+	for j := 0; j < n; j++ {
+		aug.Set(m+j, j, r)
+	}
+	// b is zero-padded to m+n elements.
+	return finiteResult(solveFullRank(aug, cloneSliceCap(b, m+n)[:m+n]))
+}
+
+// Ridge evaluates the solution of the ridge regression problem
+// (minimize ||A x - b||^2 + lambda ||x||^2)
+// for many values of lambda using a single SVD of A.
+//
+// With A = U S V', the solution is x = V diag(s / (s^2 + lambda)) U' b.
+type Ridge struct {
+	// Thin SVD of A.
+	U  *Mat
+	S  []float64
+	Vt *Mat
+	// Projection U' b of b onto the left singular vectors.
+	Beta []float64
+	// Squared norm of the component of b orthogonal to the range of U.
+	sqrResid0 float64
+}
+
+// NewRidge computes the SVD of A and projects b onto its left singular vectors.
+// Calls DGESDD.
+func NewRidge(a Const, b []float64) (*Ridge, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	u, s, vt, err := svd(cloneMat(a))
+	if err != nil {
+		return nil, err
+	}
+
+	m, k := u.Dims()
+	beta := make([]float64, k)
+	for j := 0; j < k; j++ {
+		for i := 0; i < m; i++ {
+			beta[j] += u.At(i, j) * b[i]
+		}
+	}
+	var sqrB, sqrBeta float64
+	for _, bi := range b {
+		sqrB += bi * bi
+	}
+	for _, betai := range beta {
+		sqrBeta += betai * betai
+	}
+	// Protect against small negative values due to rounding.
+	sqrResid0 := math.Max(0, sqrB-sqrBeta)
+	return &Ridge{u, s, vt, beta, sqrResid0}, nil
+}
+
+// Solve returns the ridge regression solution for lambda.
+// Lambda must be non-negative.
+// If lambda is zero and A is rank deficient,
+// the components of zero singular values are omitted,
+// which gives the minimum-norm least-squares solution
+// (the limit as lambda tends to zero).
+func (r *Ridge) Solve(lambda float64) ([]float64, error) {
+	if err := errNegLambda(lambda); err != nil {
+		return nil, err
+	}
+	return finiteResult(r.solve(lambda), nil)
+}
+
+func (r *Ridge) solve(lambda float64) []float64 {
+	k, n := r.Vt.Dims()
+	x := make([]float64, n)
+	for i := 0; i < k; i++ {
+		c := ridgeCoef(r.S[i], lambda) * r.Beta[i]
+		for j := 0; j < n; j++ {
+			x[j] += c * r.Vt.At(i, j)
+		}
+	}
+	return x
+}
+
+// ResidNorm returns ||A x - b|| where x is the solution for lambda.
+// Lambda must be non-negative (see Solve).
+func (r *Ridge) ResidNorm(lambda float64) (float64, error) {
+	if err := errNegLambda(lambda); err != nil {
+		return 0, err
+	}
+	return math.Sqrt(r.sqrResidNorm(lambda)), nil
+}
+
+func (r *Ridge) sqrResidNorm(lambda float64) float64 {
+	sqr := r.sqrResid0
+	for i, s := range r.S {
+		e := (1 - ridgeFilter(s, lambda)) * r.Beta[i]
+		sqr += e * e
+	}
+	return sqr
+}
+
+// SolnNorm returns ||x|| where x is the solution for lambda.
+// Lambda must be non-negative (see Solve).
+func (r *Ridge) SolnNorm(lambda float64) (float64, error) {
+	if err := errNegLambda(lambda); err != nil {
+		return 0, err
+	}
+	return r.solnNorm(lambda), nil
+}
+
+func (r *Ridge) solnNorm(lambda float64) float64 {
+	var sqr float64
+	for i, s := range r.S {
+		c := ridgeCoef(s, lambda) * r.Beta[i]
+		sqr += c * c
+	}
+	return math.Sqrt(sqr)
+}
+
+// GCV returns the generalized cross-validation function
+// ||A x - b||^2 / (m - sum_i f_i)^2, where x is the solution for lambda
+// and f_i = s_i^2 / (s_i^2 + lambda) are the filter factors.
+// The value of lambda which minimizes GCV is a good choice
+// when the noise level is unknown.
+// Lambda must be non-negative (see Solve).
+func (r *Ridge) GCV(lambda float64) (float64, error) {
+	if err := errNegLambda(lambda); err != nil {
+		return 0, err
+	}
+	return r.gcv(lambda), nil
+}
+
+func (r *Ridge) gcv(lambda float64) float64 {
+	m, _ := r.U.Dims()
+	dof := float64(m)
+	for _, s := range r.S {
+		dof -= ridgeFilter(s, lambda)
+	}
+	return r.sqrResidNorm(lambda) / (dof * dof)
+}
+
+// Returns s / (s^2 + lambda),
+// or zero if s is zero (the limit as lambda tends to zero).
+func ridgeCoef(s, lambda float64) float64 {
+	if s == 0 {
+		return 0
+	}
+	return s / (s*s + lambda)
+}
+
+// Returns the filter factor s^2 / (s^2 + lambda),
+// or zero if s is zero (the limit as lambda tends to zero).
+func ridgeFilter(s, lambda float64) float64 {
+	if s == 0 {
+		return 0
+	}
+	return s * s / (s*s + lambda)
+}
+
+// RidgePath describes the ridge regression solutions
+// for a sequence of values of lambda.
+type RidgePath struct {
+	Lambda []float64
+	// Solution for each lambda.
+	X [][]float64
+	// Residual norm ||A x - b|| for each lambda.
+	ResidNorm []float64
+	// Solution norm ||x|| for each lambda.
+	SolnNorm []float64
+	// Generalized cross-validation function for each lambda.
+	GCV []float64
+}
+
+// Path evaluates the solution for every value of lambda.
+// Every lambda must be non-negative (see Solve).
+func (r *Ridge) Path(lambda []float64) (*RidgePath, error) {
+	for _, l := range lambda {
+		if err := errNegLambda(l); err != nil {
+			return nil, err
+		}
+	}
+	p := &RidgePath{
+		Lambda:    cloneSlice(lambda),
+		X:         make([][]float64, len(lambda)),
+		ResidNorm: make([]float64, len(lambda)),
+		SolnNorm:  make([]float64, len(lambda)),
+		GCV:       make([]float64, len(lambda)),
+	}
+	for i, l := range lambda {
+		p.X[i] = r.solve(l)
+		p.ResidNorm[i] = math.Sqrt(r.sqrResidNorm(l))
+		p.SolnNorm[i] = r.solnNorm(l)
+		p.GCV[i] = r.gcv(l)
+	}
+	return p, nil
+}
+
+// MinGCV returns the index of the lambda which minimizes
+// the generalized cross-validation function.
+// Returns -1 if the path is empty.
+func (p *RidgePath) MinGCV() int {
+	arg := -1
+	for i, g := range p.GCV {
+		if arg < 0 || g < p.GCV[arg] {
+			arg = i
+		}
+	}
+	return arg
+}
+
+// LCurveCorner returns the index of the corner of the L-curve,
+// the point of maximum curvature of (log ||A x - b||, log ||x||).
+// The curvature at each interior point is that of the circle
+// through it and its neighbours, so lambda should be sorted
+// and sampled densely (e.g. logarithmically).
+// Returns -1 if the path has fewer than three points.
+func (p *RidgePath) LCurveCorner() int {
+	n := len(p.Lambda)
+	arg, best := -1, math.Inf(-1)
+	for i := 1; i < n-1; i++ {
+		k := mengerCurvature(
+			math.Log(p.ResidNorm[i-1]), math.Log(p.SolnNorm[i-1]),
+			math.Log(p.ResidNorm[i]), math.Log(p.SolnNorm[i]),
+			math.Log(p.ResidNorm[i+1]), math.Log(p.SolnNorm[i+1]),
+		)
+		// The corner turns anti-clockwise as lambda increases.
+		if p.Lambda[i+1] < p.Lambda[i-1] {
+			k = -k
+		}
+		if k > best {
+			arg, best = i, k
+		}
+	}
+	return arg
+}
+
+// Returns the signed curvature of the circle through three points,
+// positive if they turn anti-clockwise.
+func mengerCurvature(x1, y1, x2, y2, x3, y3 float64) float64 {
+	cross := (x2-x1)*(y3-y1) - (y2-y1)*(x3-x1)
+	a := math.Hypot(x2-x1, y2-y1)
+	b := math.Hypot(x3-x2, y3-y2)
+	c := math.Hypot(x3-x1, y3-y1)
+	if a == 0 || b == 0 || c == 0 {
+		return 0
+	}
+	return 2 * cross / (a * b * c)
+}
@@ -0,0 +1,181 @@
+package lapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Solves the normal equations (A' A + lambda I) x = A' b.
+func ridgeNormalEqs(a *mat.Mat, b []float64, lambda float64) ([]float64, error) {
+	_, n := a.Dims()
+	g := mat.Plus(mat.Mul(mat.T(a), a), mat.Scale(lambda, mat.I(n)))
+	return SolvePosDef(g, mat.MulVec(mat.T(a), b))
+}
+
+func TestSolveRidge(t *testing.T) {
+	for _, dims := range [][2]int{{150, 100}, {100, 150}} {
+		m, n := dims[0], dims[1]
+		a := randMat(m, n)
+		b := randVec(m)
+		lambda := 0.5
+
+		want, err := ridgeNormalEqs(a, b, lambda)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SolveRidge(a, b, lambda)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestRidge_Path(t *testing.T) {
+	m, n := 150, 100
+	a := randMat(m, n)
+	b := randVec(m)
+	lambdas := []float64{1e-3, 1e-1, 1, 10}
+
+	r, err := NewRidge(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := r.Path(lambdas)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, lambda := range lambdas {
+		want, err := SolveRidge(a, b, lambda)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, path.X[i])
+
+		resid := mat.MulVec(a, want)
+		for j := range resid {
+			resid[j] -= b[j]
+		}
+		if !epsEq(math.Sqrt(sqrNorm(resid)), path.ResidNorm[i], eps) {
+			t.Errorf("residual norm: want %.6g, got %.6g", math.Sqrt(sqrNorm(resid)), path.ResidNorm[i])
+		}
+		if !epsEq(math.Sqrt(sqrNorm(want)), path.SolnNorm[i], eps) {
+			t.Errorf("solution norm: want %.6g, got %.6g", math.Sqrt(sqrNorm(want)), path.SolnNorm[i])
+		}
+	}
+}
+
+func TestRidge_negLambda(t *testing.T) {
+	r, err := NewRidge(randMat(10, 5), randVec(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Solve(-1); err == nil {
+		t.Errorf("Solve: expected error for negative lambda")
+	}
+	if _, err := r.GCV(-1); err == nil {
+		t.Errorf("GCV: expected error for negative lambda")
+	}
+	if _, err := r.Path([]float64{1, -1}); err == nil {
+		t.Errorf("Path: expected error for negative lambda")
+	}
+}
+
+// Lambda of zero with a zero singular value gives the minimum-norm solution.
+func TestRidge_zeroLambdaRankDef(t *testing.T) {
+	a := mat.NewRows([][]float64{
+		{2, 0},
+		{0, 0},
+	})
+	b := []float64{4, 1}
+
+	r, err := NewRidge(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := r.Solve(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, []float64{2, 0}, x)
+	resid, err := r.ResidNorm(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !epsEq(1, resid, eps) {
+		t.Errorf("residual norm: want 1, got %.6g", resid)
+	}
+	gcv, err := r.GCV(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !epsEq(1, gcv, eps) {
+		t.Errorf("GCV: want 1, got %.6g", gcv)
+	}
+}
+
+// Checks that GCV and the L-curve choose a reasonable lambda
+// for a smooth signal observed with noise.
+func TestRidgePath_choose(t *testing.T) {
+	m, n := 200, 50
+	a := randMat(m, n)
+	// Decay singular values to make the problem ill-posed.
+	u, s, vt, err := SVD(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range s {
+		s[i] = math.Pow(0.8, float64(i))
+	}
+	a = mat.Mul(u, mat.Mul(mat.NewDiag(s), vt))
+	x := randVec(n)
+	b := mat.MulVec(a, x)
+	noise := randVec(m)
+	for i := range b {
+		b[i] += 1e-3 * noise[i]
+	}
+
+	lambdas := make([]float64, 61)
+	for i := range lambdas {
+		lambdas[i] = math.Pow(10, -12+0.2*float64(i))
+	}
+	r, err := NewRidge(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := r.Path(lambdas)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		Name string
+		Arg  int
+	}{
+		{"GCV", path.MinGCV()},
+		{"L-curve", path.LCurveCorner()},
+	} {
+		if c.Arg <= 0 || c.Arg >= len(lambdas)-1 {
+			t.Errorf("%s: lambda at end of path: %g", c.Name, lambdas[c.Arg])
+		}
+	}
+}
+
+func ExampleSolveRidge() {
+	a := mat.I(2)
+	b := []float64{2, 4}
+
+	// Minimize ||x - b||^2 + ||x||^2.
+	x, err := SolveRidge(a, b, 1)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", x)
+	// Output:
+	// [1 2]
+}
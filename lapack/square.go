@@ -3,6 +3,11 @@ package lapack
 // Solves A x = b where A is square and full-rank.
 // Calls DGESV.
 func SolveSquare(a Const, b []float64) ([]float64, error) {
+	return SolveSquareOpts(a, b, nil)
+}
+
+// SolveSquareOpts is like SolveSquare but takes options.
+func SolveSquareOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,13 +17,19 @@ func SolveSquare(a Const, b []float64) ([]float64, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	return solveSquare(cloneMat(a), cloneSlice(b))
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(solveSquare(cloneMat(a), cloneSlice(b)))
 }
 
 // a and b will be modified.
 func solveSquare(a *Mat, b []float64) ([]float64, error) {
 	n, _ := a.Dims()
-	err := dgesv(n, 1, a.Elems, n, b, n)
+	err := dgesv(nil, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,11 @@
 package lapack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/jvlmdr/lin-go/laerr"
 	"github.com/jvlmdr/lin-go/mat"
 )
 
@@ -38,3 +40,23 @@ func ExampleSolveSquare() {
 	// Output:
 	// [1 2]
 }
+
+func TestSolveSquare_singular(t *testing.T) {
+	// Second column is zero.
+	a := mat.NewRows([][]float64{
+		{1, 0, 2},
+		{2, 0, 1},
+		{3, 0, 5},
+	})
+	_, err := SolveSquare(a, []float64{1, 2, 3})
+	if !errors.Is(err, laerr.ErrSingular) {
+		t.Fatalf("expected laerr.ErrSingular, got %v", err)
+	}
+	var e *laerr.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *laerr.Error, got %T", err)
+	}
+	if e.Routine != "DGESV" || e.Index != 1 {
+		t.Errorf("want DGESV at index 1, got %s at index %d", e.Routine, e.Index)
+	}
+}
@@ -6,6 +6,9 @@ func SVD(a Const) (u *Mat, s []float64, vt *Mat, err error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, nil, nil, err
 	}
+	if err := errNonFinite(a); err != nil {
+		return nil, nil, nil, err
+	}
 	return svd(cloneMat(a))
 }
 
@@ -16,7 +19,7 @@ func svd(a *Mat) (u *Mat, s []float64, vt *Mat, err error) {
 	s = make([]float64, k)
 	vt = NewMat(k, n)
 
-	err = dgesdd(m, n, a.Elems, m, s, u.Elems, m, vt.Elems, k)
+	err = dgesdd(nil, m, n, a.Elems, m, s, u.Elems, m, vt.Elems, k)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -49,3 +49,18 @@ func TestSVD_vsEig(t *testing.T) {
 
 	testSliceEq(t, eigs, svals)
 }
+
+func TestSVD_wide(t *testing.T) {
+	// DGESDD requires 8 min(m, n) integers of iwork.
+	m, n := 60, 150
+	want := randMat(m, n)
+
+	u, s, vt, err := SVD(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check that A = U S V'.
+	got := mat.Mul(u, mat.Mul(mat.NewDiag(s), vt))
+	testMatEq(t, want, got)
+}
@@ -3,6 +3,11 @@ package lapack
 // Solves A x = b where A is symmetric.
 // Calls DSYSV.
 func SolveSymm(a Const, b []float64) ([]float64, error) {
+	return SolveSymmOpts(a, b, nil)
+}
+
+// SolveSymmOpts is like SolveSymm but takes options.
+func SolveSymmOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
 	if err := errNonPosDims(a); err != nil {
 		return nil, err
 	}
@@ -12,16 +17,25 @@ func SolveSymm(a Const, b []float64) ([]float64, error) {
 	if err := errIncompat(a, b); err != nil {
 		return nil, err
 	}
-	if err := errNonSymm(a); err != nil {
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
 		return nil, err
 	}
-	return solveSymm(cloneMat(a), cloneSlice(b))
+	return opts.finiteResult(solveSymm(cloneMat(a), cloneSlice(b), opts.tri()))
 }
 
 // a and b will be modified.
-func solveSymm(a *Mat, b []float64) ([]float64, error) {
+func solveSymm(a *Mat, b []float64, tri Triangle) ([]float64, error) {
 	n, _ := a.Dims()
-	err := dsysv(n, 1, a.Elems, n, b, n)
+	err := dsysv(nil, tri, n, 1, a.Elems, n, b, n)
 	if err != nil {
 		return nil, err
 	}
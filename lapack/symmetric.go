@@ -0,0 +1,55 @@
+package lapack
+
+// Symmetric is a view of one triangle of a square matrix as a symmetric matrix.
+// Elements outside the triangle read as their transpose.
+//
+// The triangle is not stored compactly:
+// A is a full n x n matrix, which is passed to LAPACK without unpacking.
+// At and Set do not access the elements outside the triangle
+// and the functions which take a Symmetric do not read them,
+// so they may hold anything.
+// See SymmPacked for compact storage.
+//
+// Functions which require a symmetric matrix
+// do not check the symmetry of a *Symmetric.
+type Symmetric struct {
+	// Square matrix whose elements outside the triangle are ignored.
+	A   *Mat
+	Tri Triangle
+}
+
+// Allocates a symmetric matrix of all zeros.
+func NewSymmetric(n int, tri Triangle) *Symmetric {
+	return &Symmetric{NewMat(n, n), tri}
+}
+
+// CopySymm copies one triangle of a square matrix.
+// The other triangle is not accessed.
+func CopySymm(a Const, tri Triangle) (*Symmetric, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	return &Symmetric{cloneTri(a, tri, false), tri}, nil
+}
+
+func (a *Symmetric) Dims() (rows, cols int) {
+	return a.A.Dims()
+}
+
+func (a *Symmetric) At(i, j int) float64 {
+	if otherTri(i, j, a.Tri) {
+		i, j = j, i
+	}
+	return a.A.At(i, j)
+}
+
+// Set modifies elements (i, j) and (j, i).
+func (a *Symmetric) Set(i, j int, v float64) {
+	if otherTri(i, j, a.Tri) {
+		i, j = j, i
+	}
+	a.A.Set(i, j, v)
+}
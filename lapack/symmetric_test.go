@@ -0,0 +1,41 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestCopySymm(t *testing.T) {
+	n := 10
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		s, err := CopySymm(a, tri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, a, s)
+	}
+}
+
+func TestChol_symmetric(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	// The other triangle is never read.
+	s := &Symmetric{cloneMat(spoilUpper(a)), LowerTri}
+	chol, err := Chol(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := chol.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
@@ -0,0 +1,248 @@
+package lapack
+
+// Triangular is a view of one triangle of a square matrix as a triangular matrix.
+// Elements outside the triangle read as zero
+// and, if UnitDiag is true, the diagonal reads as one.
+//
+// The triangle is not stored compactly:
+// A is a full n x n matrix, which is passed to LAPACK without unpacking.
+// At and Set do not access the elements outside the triangle
+// and the functions which take a Triangular do not read them,
+// so they may hold anything.
+type Triangular struct {
+	// Square matrix whose elements outside the triangle are ignored.
+	A        *Mat
+	Tri      Triangle
+	UnitDiag bool
+}
+
+// Allocates a triangular matrix of all zeros.
+func NewTriangular(n int, tri Triangle, unitDiag bool) *Triangular {
+	return &Triangular{NewMat(n, n), tri, unitDiag}
+}
+
+// CopyTri copies one triangle of a square matrix.
+// The elements outside the triangle are set to zero.
+// If unitDiag is true, the diagonal is not copied but set to one.
+func CopyTri(a Const, tri Triangle, unitDiag bool) (*Triangular, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	return &Triangular{cloneTri(a, tri, unitDiag), tri, unitDiag}, nil
+}
+
+func (a *Triangular) Dims() (rows, cols int) {
+	return a.A.Dims()
+}
+
+func (a *Triangular) At(i, j int) float64 {
+	if i == j && a.UnitDiag {
+		return 1
+	}
+	if otherTri(i, j, a.Tri) {
+		return 0
+	}
+	return a.A.At(i, j)
+}
+
+// Set modifies element (i, j).
+// Panics if the element is outside the triangle
+// or is on the diagonal of a unit triangular matrix.
+func (a *Triangular) Set(i, j int, v float64) {
+	if otherTri(i, j, a.Tri) || (i == j && a.UnitDiag) {
+		panic(errNotInTri(i, j, a.Tri, a.UnitDiag))
+	}
+	a.A.Set(i, j, v)
+}
+
+// Solve finds x such that A x = b (or A' x = b).
+// Calls DTRTRS.
+func (a *Triangular) Solve(t bool, b []float64) ([]float64, error) {
+	return TriSolve(a.Tri, t, a.UnitDiag, a.A, b)
+}
+
+// Inv returns the inverse, which is triangular in the same triangle.
+// Calls DTRTRI.
+func (a *Triangular) Inv() (*Triangular, error) {
+	x, err := TriInvert(a.Tri, a.UnitDiag, a.A)
+	if err != nil {
+		return nil, err
+	}
+	return &Triangular{x, a.Tri, a.UnitDiag}, nil
+}
+
+// TriSolve finds x such that A x = b (or A' x = b)
+// where A is triangular.
+// Only the elements in the specified triangle of A are accessed.
+// If unitDiag is true, the diagonal of A is assumed to be all ones.
+// Calls DTRTRS.
+func TriSolve(tri Triangle, t, unitDiag bool, a Const, b []float64) ([]float64, error) {
+	return TriSolveOpts(tri, t, unitDiag, a, b, nil)
+}
+
+// TriSolveOpts is like TriSolve but takes options.
+// The triangle is given by tri rather than Opts.Tri.
+func TriSolveOpts(tri Triangle, t, unitDiag bool, a Const, b []float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatT(a, t, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTriOf(a, tri, unitDiag); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	return opts.finiteResult(triSolve(tri, t, diagTypeOf(unitDiag), cloneTri(a, tri, unitDiag), cloneSlice(b)))
+}
+
+// b will be modified.
+func triSolve(tri Triangle, t bool, diag diagType, a *Mat, b []float64) ([]float64, error) {
+	n, _ := a.Dims()
+	err := dtrtrs(tri, t, diag, n, 1, a.Elems, n, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// TriSolveMat finds X such that A X = B (or A' X = B)
+// where A is triangular.
+// See TriSolve.
+func TriSolveMat(tri Triangle, t, unitDiag bool, a, b Const) (*Mat, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(a, t, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTri(a, tri, unitDiag); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	return finiteResultMat(triSolveMat(tri, t, diagTypeOf(unitDiag), cloneTri(a, tri, unitDiag), cloneMat(b)))
+}
+
+// b will be modified.
+func triSolveMat(tri Triangle, t bool, diag diagType, a, b *Mat) (*Mat, error) {
+	n, _ := a.Dims()
+	_, nrhs := b.Dims()
+	err := dtrtrs(tri, t, diag, n, nrhs, a.Elems, n, b.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// TriInvert computes the inverse of a triangular matrix.
+// Only the elements in the specified triangle of A are accessed.
+// The elements outside the triangle of the result are zero.
+// Calls DTRTRI.
+func TriInvert(tri Triangle, unitDiag bool, a Const) (*Mat, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTri(a, tri, unitDiag); err != nil {
+		return nil, err
+	}
+	x := cloneTri(a, tri, unitDiag)
+	return finiteResultMat(x, triInvert(tri, diagTypeOf(unitDiag), x))
+}
+
+// a will be modified.
+func triInvert(tri Triangle, diag diagType, a *Mat) error {
+	n, _ := a.Dims()
+	return dtrtri(tri, diag, n, a.Elems, n)
+}
+
+// TriMulVec computes A x (or A' x) where A is triangular.
+// Only the elements in the specified triangle of A are accessed.
+// Calls DTRMV.
+func TriMulVec(tri Triangle, t, unitDiag bool, a Const, x []float64) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatT(a, t, x); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTri(a, tri, unitDiag); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", x); err != nil {
+		return nil, err
+	}
+	x = cloneSlice(x)
+	n, _ := a.Dims()
+	dtrmv(tri, t, diagTypeOf(unitDiag), n, cloneTri(a, tri, unitDiag).Elems, n, x, 1)
+	return finiteResult(x, nil)
+}
+
+// TriMul computes A B (or A' B) where A is triangular.
+// Only the elements in the specified triangle of A are accessed.
+// Calls DTRMM.
+func TriMul(tri Triangle, t, unitDiag bool, a, b Const) (*Mat, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompatMatT(a, t, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTri(a, tri, unitDiag); err != nil {
+		return nil, err
+	}
+	if err := errNonFinite(b); err != nil {
+		return nil, err
+	}
+	x := cloneMat(b)
+	m, n := x.Dims()
+	dtrmm(left, tri, t, diagTypeOf(unitDiag), m, n, 1, cloneTri(a, tri, unitDiag).Elems, m, x.Elems, m)
+	return finiteResultMat(x, nil)
+}
+
+func diagTypeOf(unit bool) diagType {
+	if unit {
+		return unitDiag
+	}
+	return nonUnitDiag
+}
+
+// Copies one triangle of a square matrix into a new matrix.
+// The other triangle is set to zero
+// and, if unit is true, the diagonal is set to one.
+func cloneTri(src Const, tri Triangle, unit bool) *Mat {
+	n, _ := src.Dims()
+	dst := NewMat(n, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			switch {
+			case i == j && unit:
+				dst.Set(i, j, 1)
+			case !otherTri(i, j, tri):
+				dst.Set(i, j, src.At(i, j))
+			}
+		}
+	}
+	return dst
+}
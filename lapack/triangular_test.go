@@ -0,0 +1,145 @@
+package lapack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Random, well-conditioned triangular matrix.
+func randTri(n int, tri Triangle) *Triangular {
+	a := randMat(n, n)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, a.At(i, i)+float64(2*n))
+	}
+	x, err := CopyTri(a, tri, false)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+func TestTriSolve(t *testing.T) {
+	n := 100
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		for _, trans := range []bool{false, true} {
+			a := randTri(n, tri)
+			want := randVec(n)
+			var b []float64
+			if trans {
+				b = mat.MulVec(mat.T(a), want)
+			} else {
+				b = mat.MulVec(a, want)
+			}
+
+			// Pass the full matrix to check that the other triangle is ignored.
+			full := mat.New(n, n)
+			mat.Copy(full, a)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if otherTri(i, j, tri) {
+						full.Set(i, j, 1)
+					}
+				}
+			}
+
+			got, err := TriSolve(tri, trans, false, full, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testSliceEq(t, want, got)
+		}
+	}
+}
+
+func TestTriSolve_unitDiag(t *testing.T) {
+	n := 20
+	a := randTri(n, LowerTri)
+	a.UnitDiag = true
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, err := TriSolve(LowerTri, false, true, a.A, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestTriSolveMat(t *testing.T) {
+	n, k := 100, 5
+	a := randTri(n, UpperTri)
+	want := randMat(n, k)
+	b := mat.Mul(a, want)
+
+	got, err := TriSolveMat(UpperTri, false, false, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatEq(t, want, got)
+}
+
+func TestTriInvert(t *testing.T) {
+	n := 100
+	for _, tri := range []Triangle{UpperTri, LowerTri} {
+		a := randTri(n, tri)
+		b, err := a.Inv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, mat.I(n), mat.Mul(a, b))
+	}
+}
+
+func TestTriMul(t *testing.T) {
+	n, k := 50, 5
+	for _, trans := range []bool{false, true} {
+		a := randTri(n, LowerTri)
+		b := randMat(n, k)
+		var want *mat.Mat
+		if trans {
+			want = mat.Mul(mat.T(a), b)
+		} else {
+			want = mat.Mul(a, b)
+		}
+
+		got, err := TriMul(LowerTri, trans, false, a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, want, got)
+	}
+}
+
+func TestTriMulVec(t *testing.T) {
+	n := 50
+	a := randTri(n, UpperTri)
+	x := randVec(n)
+	want := mat.MulVec(a, x)
+
+	got, err := TriMulVec(UpperTri, false, false, a, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func ExampleTriSolve() {
+	a := mat.NewRows([][]float64{
+		{2, 1},
+		{0, 4},
+	})
+	// x = [1; 2]
+	// b = A x = [4; 8]
+	b := []float64{4, 8}
+
+	x, err := TriSolve(UpperTri, false, false, a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g", x)
+	// Output:
+	// [1 2]
+}
@@ -0,0 +1,137 @@
+package lapack
+
+import "github.com/jvlmdr/lin-go/vec"
+
+// Tridiag describes an n x n tridiagonal matrix by its three diagonals.
+// Only O(n) elements are stored.
+type Tridiag struct {
+	// Sub-diagonal, elements (i+1, i), length n-1.
+	Sub vec.Slice
+	// Diagonal, elements (i, i), length n.
+	Diag vec.Slice
+	// Super-diagonal, elements (i, i+1), length n-1.
+	Super vec.Slice
+}
+
+// Allocates a tridiagonal matrix of all zeros.
+func NewTridiag(n int) *Tridiag {
+	return &Tridiag{
+		Sub:   vec.MakeSlice(max(n-1, 0)),
+		Diag:  vec.MakeSlice(n),
+		Super: vec.MakeSlice(max(n-1, 0)),
+	}
+}
+
+func (a *Tridiag) Dims() (rows, cols int) {
+	n := len(a.Diag)
+	return n, n
+}
+
+func (a *Tridiag) At(i, j int) float64 {
+	switch j - i {
+	case -1:
+		return a.Sub[j]
+	case 0:
+		return a.Diag[i]
+	case 1:
+		return a.Super[i]
+	default:
+		return 0
+	}
+}
+
+func cloneTridiag(a *Tridiag) *Tridiag {
+	return &Tridiag{
+		Sub:   vec.Slice(cloneSlice(a.Sub)),
+		Diag:  vec.Slice(cloneSlice(a.Diag)),
+		Super: vec.Slice(cloneSlice(a.Super)),
+	}
+}
+
+// SolveTridiag finds x such that A x = b where A is tridiagonal.
+// Calls DGTSV.
+func SolveTridiag(a *Tridiag, b []float64) ([]float64, error) {
+	if err := errBadTridiag(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTridiag(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	return finiteResult(solveTridiag(cloneTridiag(a), cloneSlice(b)))
+}
+
+// a and b will be modified.
+func solveTridiag(a *Tridiag, b []float64) ([]float64, error) {
+	n := len(a.Diag)
+	err := dgtsv(n, 1, a.Sub, a.Diag, a.Super, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SolvePosDefTridiag finds x such that A x = b
+// where A is tridiagonal, symmetric and positive-definite.
+// Calls DPTSV.
+func SolvePosDefTridiag(a *Tridiag, b []float64) ([]float64, error) {
+	if err := errBadTridiag(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteTridiag(a); err != nil {
+		return nil, err
+	}
+	if err := errNonFiniteVec("vector", b); err != nil {
+		return nil, err
+	}
+	if err := errNonSymmTridiag(a); err != nil {
+		return nil, err
+	}
+	return finiteResult(solvePosDefTridiag(cloneTridiag(a), cloneSlice(b)))
+}
+
+// a and b will be modified.
+func solvePosDefTridiag(a *Tridiag, b []float64) ([]float64, error) {
+	n := len(a.Diag)
+	err := dptsv(n, 1, a.Diag, a.Sub, b, n)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// EigSymmTridiag computes the eigenvalue factorization
+// of a symmetric, tridiagonal matrix.
+// Eigenvalues are returned in ascending order.
+// Calls DSTEV.
+func EigSymmTridiag(a *Tridiag) (*Mat, []float64, error) {
+	if err := errBadTridiag(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonFiniteTridiag(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSymmTridiag(a); err != nil {
+		return nil, nil, err
+	}
+	return eigSymmTridiag(cloneTridiag(a))
+}
+
+// a will be modified.
+func eigSymmTridiag(a *Tridiag) (*Mat, []float64, error) {
+	n := len(a.Diag)
+	v := NewMat(n, n)
+	err := dstev(vectors, n, a.Diag, a.Sub, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, a.Diag, nil
+}
@@ -0,0 +1,128 @@
+package lapack
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+// Random, diagonally dominant tridiagonal matrix.
+func randTridiag(n int) *Tridiag {
+	a := &Tridiag{randVec(n - 1), randVec(n), randVec(n - 1)}
+	for i := range a.Diag {
+		a.Diag[i] += 4
+	}
+	return a
+}
+
+// Random symmetric, diagonally dominant tridiagonal matrix.
+func randPosDefTridiag(n int) *Tridiag {
+	a := NewTridiag(n)
+	e := randVec(n - 1)
+	copy(a.Sub, e)
+	copy(a.Super, e)
+	for i := range a.Diag {
+		a.Diag[i] = 4 + rand.Float64()
+	}
+	return a
+}
+
+func TestSolveTridiag(t *testing.T) {
+	n := 100
+	a := randTridiag(n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, err := SolveTridiag(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolvePosDefTridiag(t *testing.T) {
+	n := 100
+	a := randPosDefTridiag(n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	got, err := SolvePosDefTridiag(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func TestSolvePosDefTridiag_nonSymm(t *testing.T) {
+	a := randPosDefTridiag(10)
+	a.Super[3] += 1
+	if _, err := SolvePosDefTridiag(a, randVec(10)); err == nil {
+		t.Fatal("expected error for non-symmetric matrix")
+	}
+}
+
+func TestEigSymmTridiag(t *testing.T) {
+	n := 100
+	a := randPosDefTridiag(n)
+
+	v, d, err := EigSymmTridiag(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := mat.Mul(mat.Mul(v, mat.NewDiag(d)), mat.T(v))
+	testMatEq(t, a, got)
+}
+
+func TestEigSymmTridiag_vsEigSymm(t *testing.T) {
+	n := 100
+	a := randPosDefTridiag(n)
+
+	_, want, err := EigSymm(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, got, err := EigSymmTridiag(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceEq(t, want, got)
+}
+
+func ExampleSolveTridiag() {
+	a := &Tridiag{
+		Sub:   []float64{1, 1},
+		Diag:  []float64{2, 2, 2},
+		Super: []float64{-1, -1},
+	}
+	// x = [1; 2; 3]
+	// b = A x = [0; 2; 8]
+	b := []float64{0, 2, 8}
+
+	x, err := SolveTridiag(a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g", x)
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleEigSymmTridiag() {
+	a := &Tridiag{
+		Sub:   []float64{-2, -2},
+		Diag:  []float64{7, 6, 5},
+		Super: []float64{-2, -2},
+	}
+
+	_, d, err := EigSymmTridiag(a)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6g\n", d)
+	// Output:
+	// [3 6 9]
+}
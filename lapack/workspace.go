@@ -0,0 +1,290 @@
+package lapack
+
+// SolveFullRank is like the function SolveFullRank
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveFullRank(a Const, b []float64) ([]float64, error) {
+	return ws.SolveFullRankOpts(a, b, nil)
+}
+
+// SolveFullRankOpts is like SolveFullRank but takes options.
+func (ws *Workspace) SolveFullRankOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, max(m, n))[:max(m, n)]
+	err := dgels(ws, m, n, 1, x.Elems, m, y, len(y))
+	return opts.finiteResult(y[:n], err)
+}
+
+// Solve is like the function Solve
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) Solve(a Const, b []float64) ([]float64, error) {
+	return ws.SolveEps(a, b, DefaultEps)
+}
+
+// SolveOpts is like the function SolveOpts
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	return ws.solveEpsOpts(a, b, opts.eps(), opts)
+}
+
+// SolveEps is like the function SolveEps
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveEps(a Const, b []float64, eps float64) ([]float64, error) {
+	return ws.solveEpsOpts(a, b, eps, nil)
+}
+
+func (ws *Workspace) solveEpsOpts(a Const, b []float64, eps float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, max(m, n))[:max(m, n)]
+	_, err := dgelsd(ws, m, n, 1, x.Elems, m, y, len(y), eps)
+	return opts.finiteResult(y[:n], err)
+}
+
+// SolveSymm is like the function SolveSymm
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveSymm(a Const, b []float64) ([]float64, error) {
+	return ws.SolveSymmOpts(a, b, nil)
+}
+
+// SolveSymmOpts is like SolveSymm but takes options.
+func (ws *Workspace) SolveSymmOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := dsysv(ws, opts.tri(), n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// SVD is like the function SVD
+// but reuses the memory of the workspace.
+// The factors are overwritten by the next call.
+func (ws *Workspace) SVD(a Const) (u *Mat, s []float64, vt *Mat, err error) {
+	return ws.SVDOpts(a, nil)
+}
+
+// SVDOpts is like SVD but takes options.
+func (ws *Workspace) SVDOpts(a Const, opts *Opts) (u *Mat, s []float64, vt *Mat, err error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, nil, nil, err
+	}
+	m, n := a.Dims()
+	k := min(m, n)
+	x := ws.cloneMat("a", a)
+	u = ws.mat("svd.u", m, k)
+	s = ws.floats("svd.s", k)
+	vt = ws.mat("svd.vt", k, n)
+
+	err = dgesdd(ws, m, n, x.Elems, m, s, u.Elems, m, vt.Elems, k)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return u, s, vt, nil
+}
+
+// EigSymm is like the function EigSymm
+// but reuses the memory of the workspace.
+// The factors are overwritten by the next call.
+func (ws *Workspace) EigSymm(a Const) (*Mat, []float64, error) {
+	return ws.EigSymmOpts(a, nil)
+}
+
+// EigSymmOpts is like EigSymm but takes options.
+func (ws *Workspace) EigSymmOpts(a Const, opts *Opts) (*Mat, []float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, nil, err
+	}
+	n, _ := a.Dims()
+	v := ws.cloneMat("eig.v", a)
+	d, err := dsyev(ws, vectors, opts.tri(), n, v.Elems, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, d, nil
+}
+
+// SolveSquare is like the function SolveSquare
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolveSquare(a Const, b []float64) ([]float64, error) {
+	return ws.SolveSquareOpts(a, b, nil)
+}
+
+// SolveSquareOpts is like SolveSquare but takes options.
+func (ws *Workspace) SolveSquareOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFinite(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := dgesv(ws, n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// SolvePosDef is like the function SolvePosDef
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) SolvePosDef(a Const, b []float64) ([]float64, error) {
+	return ws.SolvePosDefOpts(a, b, nil)
+}
+
+// SolvePosDefOpts is like SolvePosDef but takes options.
+func (ws *Workspace) SolvePosDefOpts(a Const, b []float64, opts *Opts) ([]float64, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := errIncompat(a, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	y := ws.cloneSliceCap("b", b, n)
+	err := dposv(opts.tri(), n, 1, x.Elems, n, y, n)
+	return opts.finiteResult(y, err)
+}
+
+// LDL is like the function LDL
+// but reuses the memory of the workspace.
+// The factorization is overwritten by the next call.
+func (ws *Workspace) LDL(a Const) (*LDLFact, error) {
+	return ws.LDLOpts(a, nil)
+}
+
+// LDLOpts is like LDL but takes options.
+func (ws *Workspace) LDLOpts(a Const, opts *Opts) (*LDLFact, error) {
+	if err := errNonPosDims(a); err != nil {
+		return nil, err
+	}
+	if err := errNonSquare(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errBadTri(); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteTri(a); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonSymm(a); err != nil {
+		return nil, err
+	}
+	n, _ := a.Dims()
+	x := ws.cloneMat("a", a)
+	piv, err := dsytrf(ws, opts.tri(), n, x.Elems, n)
+	if err != nil {
+		return nil, err
+	}
+	ws.ldl = LDLFact{x, opts.tri(), piv}
+	return &ws.ldl, nil
+}
+
+// QRSolve is like the method Solve of QRFact
+// but reuses the memory of the workspace.
+// The solution is overwritten by the next call.
+func (ws *Workspace) QRSolve(qr *QRFact, t bool, b []float64) ([]float64, error) {
+	return ws.QRSolveOpts(qr, t, b, nil)
+}
+
+// QRSolveOpts is like QRSolve but takes options.
+func (ws *Workspace) QRSolveOpts(qr *QRFact, t bool, b []float64, opts *Opts) ([]float64, error) {
+	if err := errIncompatT(qr.A, t, b); err != nil {
+		return nil, err
+	}
+	if err := opts.errNonFiniteVec(b); err != nil {
+		return nil, err
+	}
+	m, n := qr.A.Dims()
+	return opts.finiteResult(qr.solve(ws, t, ws.cloneSliceCap("b", b, max(m, n))))
+}
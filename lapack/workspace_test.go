@@ -0,0 +1,211 @@
+package lapack
+
+import (
+	"testing"
+
+	"github.com/jvlmdr/lin-go/mat"
+)
+
+func TestWorkspace_SolveEps(t *testing.T) {
+	var ws Workspace
+	// Alternate between problem sizes to exercise the cache.
+	for _, size := range [][2]int{{150, 100}, {100, 150}, {150, 100}} {
+		m, n := size[0], size[1]
+		a, b := randMat(m, n), randVec(m)
+		want, err := SolveEps(a, b, 1e-12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ws.SolveEps(a, b, 1e-12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestWorkspace_SolveFullRank(t *testing.T) {
+	m, n := 150, 100
+	var ws Workspace
+	var prev []float64
+	for i := 0; i < 3; i++ {
+		a, b, want, err := overDetProb(m, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ws.SolveFullRank(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+		// Check that memory is reused.
+		if prev != nil && &prev[0] != &got[0] {
+			t.Errorf("solution not stored in workspace")
+		}
+		prev = got
+	}
+}
+
+func TestWorkspace_SolveSymm(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		got, err := ws.SolveSymm(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestWorkspace_EigSymm(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		v, d, err := ws.EigSymm(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, a, mat.Mul(mat.Mul(v, mat.NewDiag(d)), mat.T(v)))
+	}
+}
+
+func TestWorkspace_SVD(t *testing.T) {
+	m, n := 150, 100
+	a := randMat(m, n)
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		u, s, vt, err := ws.SVD(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatEq(t, a, mat.Mul(mat.Mul(u, mat.NewDiag(s)), vt))
+	}
+}
+
+func TestWorkspace_SolveSquare(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		got, err := ws.SolveSquare(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestWorkspace_SolvePosDef(t *testing.T) {
+	n := 100
+	a := randMat(2*n, n)
+	a = mat.Mul(mat.T(a), a)
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		got, err := ws.SolvePosDef(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestWorkspace_LDL(t *testing.T) {
+	n := 100
+	a := randMat(n, n)
+	a = mat.Plus(a, mat.T(a))
+	want := randVec(n)
+	b := mat.MulVec(a, want)
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		ldl, err := ws.LDL(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ldl.Solve(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+func TestWorkspace_QRSolve(t *testing.T) {
+	m, n := 150, 100
+	a, b, want, err := overDetProb(m, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr, err := QR(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ws Workspace
+	for i := 0; i < 2; i++ {
+		got, err := ws.QRSolve(qr, false, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testSliceEq(t, want, got)
+	}
+}
+
+// Checks that repeated calls on problems of the same size do not allocate.
+func TestWorkspace_allocs(t *testing.T) {
+	m, n := 15, 10
+	rect := randMat(m, n)
+	square := randMat(n, n)
+	symm := mat.Plus(square, mat.T(square))
+	posDef := mat.Mul(mat.T(rect), rect)
+	b, c := randVec(m), randVec(n)
+	qr, err := QR(rect)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ws Workspace
+	for _, f := range []struct {
+		Name string
+		Call func() error
+	}{
+		{"SolveFullRank", func() error { _, err := ws.SolveFullRank(rect, b); return err }},
+		{"SolveEps", func() error { _, err := ws.SolveEps(rect, b, 1e-12); return err }},
+		{"SolveSquare", func() error { _, err := ws.SolveSquare(square, c); return err }},
+		{"SolveSymm", func() error { _, err := ws.SolveSymm(symm, c); return err }},
+		{"SolvePosDef", func() error { _, err := ws.SolvePosDef(posDef, c); return err }},
+		{"LDL", func() error { _, err := ws.LDL(symm); return err }},
+		{"QRSolve", func() error { _, err := ws.QRSolve(qr, false, b); return err }},
+		{"SVD", func() error { _, _, _, err := ws.SVD(rect); return err }},
+		{"EigSymm", func() error { _, _, err := ws.EigSymm(symm); return err }},
+	} {
+		// The first call allocates the workspace.
+		if err := f.Call(); err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		allocs := testing.AllocsPerRun(10, func() {
+			if err := f.Call(); err != nil {
+				t.Fatalf("%s: %v", f.Name, err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%s: want no allocations, got %v per call", f.Name, allocs)
+		}
+	}
+}
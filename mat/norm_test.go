@@ -0,0 +1,78 @@
+package mat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNorm(t *testing.T) {
+	a := NewRows([][]float64{
+		{1, -2},
+		{-3, 4},
+	})
+	cases := []struct {
+		Kind NormKind
+		Want float64
+	}{
+		{OneNorm, 6},
+		{InfNorm, 7},
+		{FrobNorm, math.Sqrt(30)},
+		{MaxNorm, 4},
+		// Singular values are sqrt(15 +- sqrt(221)).
+		{SpectralNorm, math.Sqrt(15 + math.Sqrt(221))},
+		{NuclearNorm, math.Sqrt(15+math.Sqrt(221)) + math.Sqrt(15-math.Sqrt(221))},
+	}
+	for _, c := range cases {
+		if got := Norm(a, c.Kind); !epsEq(c.Want, got, eps) {
+			t.Errorf("%v: want %.6g, got %.6g", c.Kind, c.Want, got)
+		}
+	}
+}
+
+func TestNorm_fat(t *testing.T) {
+	// Rows are orthogonal with norms 3 and 2.
+	a := NewRows([][]float64{
+		{1, 2, 2, 0},
+		{0, 0, 0, 2},
+	})
+	if got := Norm(a, SpectralNorm); !epsEq(3, got, eps) {
+		t.Errorf("spectral: want 3, got %.6g", got)
+	}
+	if got := Norm(a, NuclearNorm); !epsEq(5, got, eps) {
+		t.Errorf("nuclear: want 5, got %.6g", got)
+	}
+}
+
+func TestNorm_converge(t *testing.T) {
+	// A = P diag(s) Q with P, Q Householder reflectors,
+	// whose columns are far from orthogonal.
+	const m, n = 10, 8
+	s := New(m, n)
+	var nuc float64
+	for i := 0; i < n; i++ {
+		s.Set(i, i, float64(i+1))
+		nuc += float64(i + 1)
+	}
+	a := Mul(Mul(reflector(m), s), reflector(n))
+	if got := Norm(a, SpectralNorm); !epsEq(n, got, 1e-10) {
+		t.Errorf("spectral: want %d, got %.6g", n, got)
+	}
+	if got := Norm(a, NuclearNorm); !epsEq(nuc, got, 1e-10) {
+		t.Errorf("nuclear: want %.6g, got %.6g", nuc, got)
+	}
+}
+
+// Returns the n x n reflector I - 2 v v' / (v' v) with v = (1, 2, ..., n).
+func reflector(n int) *Mat {
+	var vv float64
+	for i := 0; i < n; i++ {
+		vv += float64((i + 1) * (i + 1))
+	}
+	h := I(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.Set(i, j, h.At(i, j)-2*float64((i+1)*(j+1))/vv)
+		}
+	}
+	return h
+}
@@ -0,0 +1,81 @@
+package mat
+
+import "math"
+
+// Returns true if the matrix is square and
+// |a(i, j) - a(j, i)| <= eps for all i, j.
+func IsSymmetric(a Const, eps float64) bool {
+	m, n := a.Dims()
+	if m != n {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if math.Abs(a.At(i, j)-a.At(j, i)) > eps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns true if |a(i, j)| <= eps for all i != j.
+// The matrix need not be square.
+func IsDiagonal(a Const, eps float64) bool {
+	return IsBanded(a, 0, 0, eps)
+}
+
+// Returns true if |a(i, j)| <= eps for all i > j.
+// The matrix need not be square.
+func IsUpperTriangular(a Const, eps float64) bool {
+	_, n := a.Dims()
+	return IsBanded(a, 0, max(n-1, 0), eps)
+}
+
+// Returns true if |a(i, j)| <= eps for all i < j.
+// The matrix need not be square.
+func IsLowerTriangular(a Const, eps float64) bool {
+	m, _ := a.Dims()
+	return IsBanded(a, max(m-1, 0), 0, eps)
+}
+
+// Returns true if the matrix has lower bandwidth kl and upper bandwidth ku,
+// that is |a(i, j)| <= eps for all i-j > kl and j-i > ku.
+func IsBanded(a Const, kl, ku int, eps float64) bool {
+	m, n := a.Dims()
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if i-j <= kl && j-i <= ku {
+				continue
+			}
+			if math.Abs(a.At(i, j)) > eps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Returns true if the matrix is square and
+// every element of A' A differs from the identity by at most eps.
+func IsOrthogonal(a Const, eps float64) bool {
+	m, n := a.Dims()
+	if m != n {
+		return false
+	}
+	for p := 0; p < n; p++ {
+		for q := p; q < n; q++ {
+			var dot float64
+			for i := 0; i < m; i++ {
+				dot += a.At(i, p) * a.At(i, q)
+			}
+			if p == q {
+				dot -= 1
+			}
+			if math.Abs(dot) > eps {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package mat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsSymmetric(t *testing.T) {
+	a := NewRows([][]float64{
+		{1, 2},
+		{2 + 1e-9, 3},
+	})
+	if !IsSymmetric(a, 1e-6) {
+		t.Error("want symmetric within 1e-6")
+	}
+	if IsSymmetric(a, 1e-12) {
+		t.Error("want not symmetric within 1e-12")
+	}
+	if IsSymmetric(New(2, 3), 1) {
+		t.Error("want non-square not symmetric")
+	}
+}
+
+func TestIsBanded(t *testing.T) {
+	a := NewRows([][]float64{
+		{1, 2, 0, 0},
+		{3, 4, 5, 0},
+		{0, 6, 7, 8},
+	})
+	if !IsBanded(a, 1, 1, 0) {
+		t.Error("want tridiagonal")
+	}
+	if IsBanded(a, 0, 1, 0) {
+		t.Error("want not upper bidiagonal")
+	}
+	if IsDiagonal(a, 0) || IsUpperTriangular(a, 0) || IsLowerTriangular(a, 0) {
+		t.Error("want not diagonal or triangular")
+	}
+	if !IsUpperTriangular(NewRows([][]float64{{1, 2, 3}, {0, 4, 5}}), 0) {
+		t.Error("want upper triangular")
+	}
+	if !IsLowerTriangular(NewRows([][]float64{{1, 0}, {2, 3}, {4, 5}}), 0) {
+		t.Error("want lower triangular")
+	}
+	if !IsDiagonal(NewDiag([]float64{1, 2, 3}), 0) {
+		t.Error("want diagonal")
+	}
+}
+
+func TestIsOrthogonal(t *testing.T) {
+	c, s := math.Cos(0.3), math.Sin(0.3)
+	q := NewRows([][]float64{
+		{c, -s},
+		{s, c},
+	})
+	if !IsOrthogonal(q, eps) {
+		t.Error("want rotation orthogonal")
+	}
+	if IsOrthogonal(Scale(2, q), eps) {
+		t.Error("want scaled rotation not orthogonal")
+	}
+}
@@ -0,0 +1,71 @@
+package poly
+
+// Adds two polynomials.
+func Plus(p, q Poly) Poly {
+	r := make([]float64, max(len(p.Coeffs), len(q.Coeffs)))
+	copy(r, p.Coeffs)
+	for i, c := range q.Coeffs {
+		r[i] += c
+	}
+	return New(r...)
+}
+
+// Subtracts one polynomial from another.
+func Minus(p, q Poly) Poly {
+	return Plus(p, Scale(-1, q))
+}
+
+// Multiplies a polynomial by a scalar.
+func Scale(k float64, p Poly) Poly {
+	r := make([]float64, len(p.Coeffs))
+	for i, c := range p.Coeffs {
+		r[i] = k * c
+	}
+	return New(r...)
+}
+
+// Multiplies two polynomials.
+func Mul(p, q Poly) Poly {
+	if len(p.Coeffs) == 0 || len(q.Coeffs) == 0 {
+		return New()
+	}
+	r := make([]float64, len(p.Coeffs)+len(q.Coeffs)-1)
+	for i, a := range p.Coeffs {
+		for j, b := range q.Coeffs {
+			r[i+j] += a * b
+		}
+	}
+	return New(r...)
+}
+
+// Divides one polynomial by another,
+// returning the quotient and remainder such that p = quo q + rem
+// and the degree of rem is less than that of q.
+//
+// Panics if q is the zero polynomial.
+func Div(p, q Poly) (quo, rem Poly) {
+	n := q.Degree()
+	if n < 0 {
+		panic("division by zero polynomial")
+	}
+	r := cloneSlice(p.Coeffs)
+	m := p.Degree()
+	if m < n {
+		return New(), New(r...)
+	}
+	d := make([]float64, m-n+1)
+	for i := m - n; i >= 0; i-- {
+		d[i] = r[i+n] / q.Coeffs[n]
+		for j := 0; j <= n; j++ {
+			r[i+j] -= d[i] * q.Coeffs[j]
+		}
+	}
+	return New(d...), New(r[:n]...)
+}
+
+func max(a, b int) int {
+	if a < b {
+		return b
+	}
+	return a
+}
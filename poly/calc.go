@@ -0,0 +1,24 @@
+package poly
+
+// Deriv returns the derivative of a polynomial.
+func Deriv(p Poly) Poly {
+	if len(p.Coeffs) <= 1 {
+		return New()
+	}
+	r := make([]float64, len(p.Coeffs)-1)
+	for i := range r {
+		r[i] = float64(i+1) * p.Coeffs[i+1]
+	}
+	return New(r...)
+}
+
+// Integ returns the antiderivative of a polynomial
+// whose value at zero is c.
+func Integ(p Poly, c float64) Poly {
+	r := make([]float64, len(p.Coeffs)+1)
+	r[0] = c
+	for i, a := range p.Coeffs {
+		r[i+1] = a / float64(i+1)
+	}
+	return New(r...)
+}
@@ -0,0 +1,10 @@
+/*
+Package poly provides real polynomials in one variable.
+
+A polynomial is stored as its coefficients in order of increasing degree,
+so that New(1, 0, -2) is 1 - 2 x^2.
+
+Fit finds least-squares polynomials using package lapack
+and Roots finds roots as the eigenvalues of the companion matrix using package clap.
+*/
+package poly
@@ -0,0 +1,38 @@
+package poly
+
+import (
+	"fmt"
+
+	"github.com/jvlmdr/lin-go/lapack"
+)
+
+// Fit finds the polynomial of degree at most deg
+// which minimizes the sum of squared errors sum_i (p(x[i]) - y[i])^2.
+// Requires more than deg distinct points.
+// Calls lapack.SolveFullRank.
+func Fit(x, y []float64, deg int) (Poly, error) {
+	if len(x) != len(y) {
+		return Poly{}, fmt.Errorf("different lengths: %d and %d", len(x), len(y))
+	}
+	if deg < 0 {
+		return Poly{}, fmt.Errorf("degree negative: %d", deg)
+	}
+	if len(x) <= deg {
+		return Poly{}, fmt.Errorf("too few points for degree %d: %d", deg, len(x))
+	}
+
+	// Vandermonde matrix.
+	a := lapack.NewMat(len(x), deg+1)
+	for i, xi := range x {
+		v := 1.0
+		for j := 0; j <= deg; j++ {
+			a.Set(i, j, v)
+			v *= xi
+		}
+	}
+	c, err := lapack.SolveFullRank(a, y)
+	if err != nil {
+		return Poly{}, err
+	}
+	return New(c...), nil
+}
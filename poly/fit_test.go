@@ -0,0 +1,18 @@
+package poly
+
+import "testing"
+
+func TestFit(t *testing.T) {
+	want := New(2, -1, 0.5)
+	var x, y []float64
+	for i := 0; i < 10; i++ {
+		xi := float64(i) / 3
+		x = append(x, xi)
+		y = append(y, want.Eval(xi))
+	}
+	got, err := Fit(x, y, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testPolyEq(t, want, got)
+}
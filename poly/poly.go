@@ -0,0 +1,78 @@
+package poly
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jvlmdr/lin-go/vec"
+)
+
+// Poly describes the polynomial
+// Coeffs[0] + Coeffs[1] x + ... + Coeffs[n-1] x^(n-1).
+// Trailing zero coefficients are allowed.
+type Poly struct {
+	Coeffs vec.Slice
+}
+
+// Creates a polynomial from its coefficients in order of increasing degree.
+func New(coeffs ...float64) Poly {
+	return Poly{vec.Slice(coeffs)}
+}
+
+// Degree returns the largest power with a non-zero coefficient,
+// or -1 for the zero polynomial.
+func (p Poly) Degree() int {
+	for i := len(p.Coeffs) - 1; i >= 0; i-- {
+		if p.Coeffs[i] != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Eval evaluates the polynomial by Horner's method.
+func (p Poly) Eval(x float64) float64 {
+	var y float64
+	for i := len(p.Coeffs) - 1; i >= 0; i-- {
+		y = y*x + p.Coeffs[i]
+	}
+	return y
+}
+
+// EvalCmplx evaluates the polynomial at a complex number by Horner's method.
+func (p Poly) EvalCmplx(z complex128) complex128 {
+	var y complex128
+	for i := len(p.Coeffs) - 1; i >= 0; i-- {
+		y = y*z + complex(p.Coeffs[i], 0)
+	}
+	return y
+}
+
+// Returns a copy with trailing zero coefficients removed.
+func (p Poly) Trim() Poly {
+	return New(cloneSlice(p.Coeffs[:p.Degree()+1])...)
+}
+
+func (p Poly) String() string {
+	var terms []string
+	for i, c := range p.Coeffs {
+		switch {
+		case c == 0:
+			continue
+		case i == 0:
+			terms = append(terms, fmt.Sprint(c))
+		case i == 1:
+			terms = append(terms, fmt.Sprintf("%g x", c))
+		default:
+			terms = append(terms, fmt.Sprintf("%g x^%d", c, i))
+		}
+	}
+	if len(terms) == 0 {
+		return "0"
+	}
+	return strings.Join(terms, " + ")
+}
+
+func cloneSlice(x []float64) []float64 {
+	return append([]float64(nil), x...)
+}
@@ -0,0 +1,67 @@
+package poly
+
+import (
+	"math"
+	"testing"
+)
+
+const eps = 1e-9
+
+func testPolyEq(t *testing.T, want, got Poly) {
+	n := max(len(want.Coeffs), len(got.Coeffs))
+	for i := 0; i < n; i++ {
+		var u, v float64
+		if i < len(want.Coeffs) {
+			u = want.Coeffs[i]
+		}
+		if i < len(got.Coeffs) {
+			v = got.Coeffs[i]
+		}
+		if math.Abs(u-v) > eps {
+			t.Errorf("coeff %d: want %g, got %g", i, u, v)
+		}
+	}
+}
+
+func TestPoly_Eval(t *testing.T) {
+	// 1 - 2 x^2
+	p := New(1, 0, -2)
+	if got := p.Eval(3); got != -17 {
+		t.Errorf("want -17, got %g", got)
+	}
+	if got := p.EvalCmplx(1i); got != 3 {
+		t.Errorf("want 3, got %v", got)
+	}
+}
+
+func TestPoly_Degree(t *testing.T) {
+	if d := New(1, 2, 0, 0).Degree(); d != 1 {
+		t.Errorf("want 1, got %d", d)
+	}
+	if d := New(0, 0).Degree(); d != -1 {
+		t.Errorf("want -1, got %d", d)
+	}
+}
+
+func TestMul(t *testing.T) {
+	// (1 + x) (1 - x) = 1 - x^2
+	got := Mul(New(1, 1), New(1, -1))
+	testPolyEq(t, New(1, 0, -1), got)
+}
+
+func TestDiv(t *testing.T) {
+	p := New(3, -2, 0, 5, 1)
+	q := New(-1, 0, 2)
+	quo, rem := Div(p, q)
+	if rem.Degree() >= q.Degree() {
+		t.Errorf("remainder degree %d not less than %d", rem.Degree(), q.Degree())
+	}
+	testPolyEq(t, p, Plus(Mul(quo, q), rem))
+}
+
+func TestDerivInteg(t *testing.T) {
+	p := New(1, 2, 3)
+	testPolyEq(t, New(2, 6), Deriv(p))
+	testPolyEq(t, New(4, 1, 1, 1), Integ(p, 4))
+	testPolyEq(t, p, Deriv(Integ(p, 0)))
+}
@@ -0,0 +1,38 @@
+package poly
+
+import (
+	"errors"
+
+	"github.com/jvlmdr/lin-go/clap"
+)
+
+// Roots finds the complex roots of a polynomial,
+// repeated according to multiplicity.
+// Returns n roots for a polynomial of degree n.
+// The roots are the eigenvalues of the companion matrix.
+// Calls clap.Eig.
+func Roots(p Poly) ([]complex128, error) {
+	n := p.Degree()
+	if n < 0 {
+		return nil, errors.New("zero polynomial")
+	}
+	if n == 0 {
+		return []complex128{}, nil
+	}
+
+	// Companion matrix of the monic polynomial,
+	// with ones on the subdiagonal and coefficients in the last column.
+	a := clap.NewMat(n, n)
+	for i := 1; i < n; i++ {
+		a.Set(i, i-1, 1)
+	}
+	lead := p.Coeffs[n]
+	for i := 0; i < n; i++ {
+		a.Set(i, n-1, complex(-p.Coeffs[i]/lead, 0))
+	}
+	_, d, err := clap.Eig(a)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,29 @@
+package poly
+
+import (
+	"math/cmplx"
+	"testing"
+)
+
+func TestRoots(t *testing.T) {
+	// (x - 2) (x^2 + 1)
+	p := Mul(New(-2, 1), New(1, 0, 1))
+	roots, err := Roots(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 3 {
+		t.Fatalf("want 3 roots, got %d", len(roots))
+	}
+	for _, want := range []complex128{2, 1i, -1i} {
+		var found bool
+		for _, z := range roots {
+			if cmplx.Abs(z-want) <= eps {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("root %v not found in %v", want, roots)
+		}
+	}
+}